@@ -85,8 +85,8 @@ func handleMessage(bot *telegram.TelegramBot, message *telegram.Message) {
 	case "/photo":
 		_, err := bot.SendPhoto(&telegram.PhotoRequest{
 			ChatID: message.Chat.ID,
-			Photo:  "file:///tmp/image.png",
-			// Photo:   "https://plus.unsplash.com/premium_photo-1675848495392-6b9a3b962df0",
+			Photo:  telegram.FilePath("/tmp/image.png"),
+			// Photo:   telegram.FileURL("https://plus.unsplash.com/premium_photo-1675848495392-6b9a3b962df0"),
 			Caption: "Here is a photo",
 		})
 		if err != nil {
@@ -96,7 +96,7 @@ func handleMessage(bot *telegram.TelegramBot, message *telegram.Message) {
 		// Test SendVideo with local file
 		_, err := bot.SendVideo(&telegram.VideoRequest{
 			ChatID:  message.Chat.ID,
-			Video:   "file://./test_video.mp4",
+			Video:   telegram.FilePath("./test_video.mp4"),
 			Caption: "Here is a 10s video from RTSP stream",
 		})
 		if err != nil {
@@ -106,7 +106,7 @@ func handleMessage(bot *telegram.TelegramBot, message *telegram.Message) {
 		// Test SendDocument with local file
 		_, err := bot.SendDocument(&telegram.DocumentRequest{
 			ChatID:   message.Chat.ID,
-			Document: "file://./test_video.mp4",
+			Document: telegram.FilePath("./test_video.mp4"),
 			Caption:  "Here is a document (video file)",
 		})
 		if err != nil {
@@ -0,0 +1,176 @@
+package passport
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lsongdev/telegram-go/telegram"
+)
+
+// PersonalDetails represents personal details.
+// @docs https://core.telegram.org/passport#personaldetails
+type PersonalDetails struct {
+	FirstName            string `json:"first_name"`
+	LastName             string `json:"last_name"`
+	MiddleName           string `json:"middle_name,omitempty"`
+	BirthDate            string `json:"birth_date"` // DD.MM.YYYY
+	Gender               string `json:"gender"`     // "male" | "female"
+	CountryCode          string `json:"country_code"`
+	ResidenceCountryCode string `json:"residence_country_code"`
+	// In the language of the user's country of residence
+	FirstNameNative  string `json:"first_name_native,omitempty"`
+	LastNameNative   string `json:"last_name_native,omitempty"`
+	MiddleNameNative string `json:"middle_name_native,omitempty"`
+}
+
+// ResidentialAddress represents a residential address.
+// @docs https://core.telegram.org/passport#residentialaddress
+type ResidentialAddress struct {
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2,omitempty"`
+	City        string `json:"city"`
+	State       string `json:"state,omitempty"`
+	CountryCode string `json:"country_code"`
+	PostCode    string `json:"post_code"`
+}
+
+// IDDocumentData represents the data of an identity document.
+// @docs https://core.telegram.org/passport#iddocumentdata
+type IDDocumentData struct {
+	DocumentNo string `json:"document_no"`
+	ExpiryDate string `json:"expiry_date,omitempty"` // DD.MM.YYYY
+}
+
+// File is a file of an element along with the credentials to decrypt it.
+type File struct {
+	*telegram.PassportFile
+	Credentials *FileCredentials
+}
+
+// Decrypt decrypts the content of the file once downloaded.
+func (f *File) Decrypt(encrypted []byte) ([]byte, error) {
+	if f.Credentials == nil {
+		return nil, ErrMissingCredentials
+	}
+	return f.Credentials.Decrypt(encrypted)
+}
+
+// IdentityDocument is a decrypted passport, driver license, identity card or
+// internal passport, with the files of its scans.
+type IdentityDocument struct {
+	Type string
+	IDDocumentData
+	FrontSide   *File
+	ReverseSide *File // "driver_license" and "identity_card" only
+	Selfie      *File
+	Translation []*File
+}
+
+// AddressDocument is a proof of address, such as a utility bill or a rental agreement.
+type AddressDocument struct {
+	Type        string
+	Files       []*File
+	Translation []*File
+}
+
+// Passport is the decrypted content of telegram.PassportData.
+type Passport struct {
+	Nonce           string
+	PersonalDetails *PersonalDetails
+	Address         *ResidentialAddress
+	PhoneNumber     string
+	Email           string
+	// Identity documents and proofs of address by element type
+	Documents        map[string]*IdentityDocument
+	AddressDocuments map[string]*AddressDocument
+}
+
+// Decrypt decrypts passport data with the bot's private key.
+func Decrypt(key *rsa.PrivateKey, data *telegram.PassportData) (*Passport, error) {
+	credentials, err := DecryptCredentials(key, data.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	p := &Passport{
+		Nonce:            credentials.Nonce,
+		Documents:        make(map[string]*IdentityDocument),
+		AddressDocuments: make(map[string]*AddressDocument),
+	}
+	for _, element := range data.Data {
+		if err := p.add(element, credentials.SecureData[element.Type]); err != nil {
+			return nil, fmt.Errorf("passport: %s: %w", element.Type, err)
+		}
+	}
+	return p, nil
+}
+
+func (p *Passport) add(element *telegram.EncryptedPassportElement, value *SecureValue) error {
+	switch element.Type {
+	case telegram.PassportElementPhoneNumber:
+		p.PhoneNumber = element.PhoneNumber
+		return nil
+	case telegram.PassportElementEmail:
+		p.Email = element.Email
+		return nil
+	}
+	if value == nil {
+		return ErrMissingCredentials
+	}
+	switch element.Type {
+	case telegram.PassportElementPersonalDetails:
+		p.PersonalDetails = &PersonalDetails{}
+		return decryptJSON(element.Data, value.Data, p.PersonalDetails)
+	case telegram.PassportElementAddress:
+		p.Address = &ResidentialAddress{}
+		return decryptJSON(element.Data, value.Data, p.Address)
+	case telegram.PassportElementPassport, telegram.PassportElementDriverLicense,
+		telegram.PassportElementIdentityCard, telegram.PassportElementInternalPassport:
+		doc := &IdentityDocument{
+			Type:        element.Type,
+			FrontSide:   file(element.FrontSide, value.FrontSide),
+			ReverseSide: file(element.ReverseSide, value.ReverseSide),
+			Selfie:      file(element.Selfie, value.Selfie),
+			Translation: files(element.Translation, value.Translation),
+		}
+		p.Documents[element.Type] = doc
+		return decryptJSON(element.Data, value.Data, &doc.IDDocumentData)
+	default:
+		p.AddressDocuments[element.Type] = &AddressDocument{
+			Type:        element.Type,
+			Files:       files(element.Files, value.Files),
+			Translation: files(element.Translation, value.Translation),
+		}
+		return nil
+	}
+}
+
+func decryptJSON(data string, credentials *DataCredentials, v any) error {
+	if credentials == nil {
+		return ErrMissingCredentials
+	}
+	plain, err := credentials.Decrypt(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(plain, v)
+}
+
+func file(f *telegram.PassportFile, credentials *FileCredentials) *File {
+	if f == nil {
+		return nil
+	}
+	return &File{PassportFile: f, Credentials: credentials}
+}
+
+// files pairs files with their credentials, given in the same order.
+func files(list []*telegram.PassportFile, credentials []*FileCredentials) (result []*File) {
+	for i, f := range list {
+		var c *FileCredentials
+		if i < len(credentials) {
+			c = credentials[i]
+		}
+		result = append(result, file(f, c))
+	}
+	return
+}
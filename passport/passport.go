@@ -0,0 +1,183 @@
+// Package passport decrypts the Telegram Passport data shared with a bot.
+//
+// Passport data is received in Message.PassportData, encrypted with the
+// public key the bot registered with @BotFather. Decrypt it with the matching
+// private key:
+//
+//	key, err := passport.ParsePrivateKey(pemData)
+//	p, err := passport.Decrypt(key, msg.PassportData)
+//	if p.Nonce != expectedNonce {
+//		// reject the request
+//	}
+//	log.Println(p.PersonalDetails.FirstName)
+//
+// Files are not sent along with the data; download them with GetFile and
+// decrypt them with File.Decrypt.
+//
+// @docs https://core.telegram.org/passport#receiving-information
+package passport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/lsongdev/telegram-go/telegram"
+)
+
+var (
+	// ErrInvalidKey is returned by ParsePrivateKey for keys other than RSA private keys.
+	ErrInvalidKey = errors.New("passport: invalid RSA private key")
+	// ErrInvalidHash is returned when decrypted data doesn't match its hash,
+	// meaning the data was tampered with or the wrong secret was used.
+	ErrInvalidHash = errors.New("passport: data hash mismatch")
+	// ErrMissingCredentials is returned for an element or file without credentials.
+	ErrMissingCredentials = errors.New("passport: missing credentials")
+)
+
+// ParsePrivateKey parses a PEM encoded RSA private key, in PKCS #1 or PKCS #8 form.
+func ParsePrivateKey(pemData []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// Credentials is the decrypted content of telegram.EncryptedCredentials.
+// @docs https://core.telegram.org/passport#credentials
+type Credentials struct {
+	SecureData SecureData `json:"secure_data"`
+	// Nonce is the payload of the authorization request, which the bot
+	// must check to make sure the data was requested by itself.
+	Nonce string `json:"nonce"`
+}
+
+// SecureData maps element types to the credentials of their data and files.
+// @docs https://core.telegram.org/passport#securedata
+type SecureData map[string]*SecureValue
+
+// SecureValue represents the credentials required to decrypt an element.
+// @docs https://core.telegram.org/passport#securevalue
+type SecureValue struct {
+	Data        *DataCredentials   `json:"data,omitempty"`
+	FrontSide   *FileCredentials   `json:"front_side,omitempty"`
+	ReverseSide *FileCredentials   `json:"reverse_side,omitempty"`
+	Selfie      *FileCredentials   `json:"selfie,omitempty"`
+	Translation []*FileCredentials `json:"translation,omitempty"`
+	Files       []*FileCredentials `json:"files,omitempty"`
+}
+
+// DataCredentials can be used to decrypt the data of an element.
+// @docs https://core.telegram.org/passport#datacredentials
+type DataCredentials struct {
+	DataHash string `json:"data_hash"`
+	Secret   string `json:"secret"`
+}
+
+// FileCredentials can be used to decrypt a file of an element.
+// @docs https://core.telegram.org/passport#filecredentials
+type FileCredentials struct {
+	FileHash string `json:"file_hash"`
+	Secret   string `json:"secret"`
+}
+
+// DecryptCredentials decrypts the credentials of passport data with the bot's private key.
+func DecryptCredentials(key *rsa.PrivateKey, encrypted *telegram.EncryptedCredentials) (*Credentials, error) {
+	secret, err := base64.StdEncoding.DecodeString(encrypted.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("passport: secret: %w", err)
+	}
+	secret, err = rsa.DecryptOAEP(sha1.New(), nil, key, secret, nil)
+	if err != nil {
+		return nil, fmt.Errorf("passport: secret: %w", err)
+	}
+	hash, err := base64.StdEncoding.DecodeString(encrypted.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("passport: hash: %w", err)
+	}
+	data, err := base64.StdEncoding.DecodeString(encrypted.Data)
+	if err != nil {
+		return nil, fmt.Errorf("passport: data: %w", err)
+	}
+	plain, err := decrypt(data, secret, hash)
+	if err != nil {
+		return nil, err
+	}
+	var credentials Credentials
+	if err := json.Unmarshal(plain, &credentials); err != nil {
+		return nil, fmt.Errorf("passport: credentials: %w", err)
+	}
+	return &credentials, nil
+}
+
+// Decrypt decrypts base64-encoded element data with its credentials.
+func (c *DataCredentials) Decrypt(data string) ([]byte, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("passport: data: %w", err)
+	}
+	return decryptWith(encrypted, c.Secret, c.DataHash)
+}
+
+// Decrypt decrypts the content of a file downloaded from Telegram with its credentials.
+func (c *FileCredentials) Decrypt(encrypted []byte) ([]byte, error) {
+	return decryptWith(encrypted, c.Secret, c.FileHash)
+}
+
+func decryptWith(encrypted []byte, secret, hash string) ([]byte, error) {
+	rawSecret, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("passport: secret: %w", err)
+	}
+	rawHash, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("passport: hash: %w", err)
+	}
+	return decrypt(encrypted, rawSecret, rawHash)
+}
+
+// decrypt decrypts data with AES-256-CBC, with the key and IV derived from
+// SHA512(secret + hash), checks its SHA256 against hash and strips its padding,
+// whose length is given by its first byte.
+func decrypt(data, secret, hash []byte) ([]byte, error) {
+	digest := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(digest[:32])
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("passport: encrypted data length %d is not a multiple of the block size", len(data))
+	}
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, digest[32:48]).CryptBlocks(plain, data)
+	if sum := sha256.Sum256(plain); !bytes.Equal(sum[:], hash) {
+		return nil, ErrInvalidHash
+	}
+	padding := int(plain[0])
+	if padding < 32 || padding > len(plain) {
+		return nil, fmt.Errorf("passport: invalid padding length %d", padding)
+	}
+	return plain[padding:], nil
+}
@@ -0,0 +1,102 @@
+package passport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/lsongdev/telegram-go/telegram"
+)
+
+// encrypt encrypts data as Telegram does, returning it with its hash.
+func encrypt(t *testing.T, data, secret []byte) (encrypted, hash []byte) {
+	padding := 32 + (16-(len(data)+32)%16)%16
+	plain := make([]byte, padding, padding+len(data))
+	rand.Read(plain)
+	plain[0] = byte(padding)
+	plain = append(plain, data...)
+	sum := sha256.Sum256(plain)
+	hash = sum[:]
+	digest := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(digest[:32])
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted = make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, digest[32:48]).CryptBlocks(encrypted, plain)
+	return
+}
+
+func TestDecrypt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if key, err = ParsePrivateKey(pemData); err != nil {
+		t.Fatal(err)
+	}
+	b64 := base64.StdEncoding.EncodeToString
+
+	details, _ := json.Marshal(&PersonalDetails{FirstName: "Ada", LastName: "Lovelace"})
+	dataSecret := make([]byte, 32)
+	rand.Read(dataSecret)
+	detailsData, detailsHash := encrypt(t, details, dataSecret)
+
+	fileSecret := make([]byte, 32)
+	rand.Read(fileSecret)
+	scan, scanHash := encrypt(t, []byte("jpeg"), fileSecret)
+
+	credentials, _ := json.Marshal(&Credentials{
+		Nonce: "nonce",
+		SecureData: SecureData{
+			"personal_details": {Data: &DataCredentials{DataHash: b64(detailsHash), Secret: b64(dataSecret)}},
+			"utility_bill":     {Files: []*FileCredentials{{FileHash: b64(scanHash), Secret: b64(fileSecret)}}},
+		},
+	})
+	secret := make([]byte, 32)
+	rand.Read(secret)
+	credentialsData, credentialsHash := encrypt(t, credentials, secret)
+	encryptedSecret, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.PublicKey, secret, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &telegram.PassportData{
+		Data: []*telegram.EncryptedPassportElement{
+			{Type: "personal_details", Data: b64(detailsData)},
+			{Type: "utility_bill", Files: []*telegram.PassportFile{{FileID: "scan"}}},
+			{Type: "email", Email: "ada@example.com"},
+		},
+		Credentials: &telegram.EncryptedCredentials{
+			Data:   b64(credentialsData),
+			Hash:   b64(credentialsHash),
+			Secret: b64(encryptedSecret),
+		},
+	}
+	p, err := Decrypt(key, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Nonce != "nonce" || p.PersonalDetails.FirstName != "Ada" || p.Email != "ada@example.com" {
+		t.Errorf("got %+v", p)
+	}
+	bill := p.AddressDocuments["utility_bill"]
+	content, err := bill.Files[0].Decrypt(scan)
+	if err != nil || string(content) != "jpeg" {
+		t.Errorf("file: got %q, %v", content, err)
+	}
+	scan[len(scan)-1] ^= 1
+	if _, err := bill.Files[0].Decrypt(scan); !errors.Is(err, ErrInvalidHash) {
+		t.Errorf("expected ErrInvalidHash, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package telegram
+
+import "fmt"
+
+type MediaGroupRequest struct {
+	BusinessConnectionID  string `json:"business_connection_id,omitempty"`
+	ChatID                any    `json:"chat_id"`
+	MessageThreadID       int64  `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64  `json:"direct_messages_topic_id,omitempty"`
+	// An array describing messages to be sent, must include 2-10 items.
+	// Documents and audio files can be only grouped in an album with messages of the same type.
+	Media               []InputMedia     `json:"media"`
+	DisableNotification bool             `json:"disable_notification,omitempty"`
+	ProtectContent      bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast  bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID     string           `json:"message_effect_id,omitempty"`
+	ReplyParameters     *ReplyParameters `json:"reply_parameters,omitempty"`
+}
+
+// SendMediaGroup sends a group of photos, videos, documents or audios as an album.
+// Uploaded files are sent in a single multipart request using the attach:// scheme.
+// On success, the messages of the album are returned.
+// https://core.telegram.org/bots/api#sendmediagroup
+func (bot *TelegramBot) SendMediaGroup(req *MediaGroupRequest) (messages []*Message, err error) {
+	if err = validateMediaGroup(req.Media); err != nil {
+		return
+	}
+	err = bot.CallMethod("sendMediaGroup", req, &messages)
+	return
+}
+
+func validateMediaGroup(media []InputMedia) error {
+	if len(media) < 2 || len(media) > 10 {
+		return fmt.Errorf("telegram: media group must include 2-10 items, got %d", len(media))
+	}
+	first := media[0].MediaType()
+	for _, m := range media {
+		switch t := m.MediaType(); t {
+		case "photo", "video":
+			if first != "photo" && first != "video" {
+				return fmt.Errorf("telegram: can't group %s with %s", t, first)
+			}
+		case "audio", "document":
+			if t != first {
+				return fmt.Errorf("telegram: %s can be only grouped with items of the same type, got %s", t, first)
+			}
+		default:
+			return fmt.Errorf("telegram: %s can't be sent in a media group", t)
+		}
+	}
+	return nil
+}
+
+// AlbumBuilder builds the media of a media group.
+//
+//	album := telegram.NewAlbum().
+//		Photo(telegram.FilePath("a.jpg"), "Our trip").
+//		Photo(telegram.FileID(fileID), "").
+//		Video(telegram.FileURL(url), "")
+//	bot.SendMediaGroup(album.Request(chatID))
+type AlbumBuilder struct {
+	media []InputMedia
+}
+
+// NewAlbum returns an empty album.
+func NewAlbum() *AlbumBuilder {
+	return &AlbumBuilder{}
+}
+
+// Photo appends a photo.
+func (a *AlbumBuilder) Photo(media *InputFile, caption string) *AlbumBuilder {
+	return a.Add(NewInputMediaPhoto(media, caption))
+}
+
+// Video appends a video.
+func (a *AlbumBuilder) Video(media *InputFile, caption string) *AlbumBuilder {
+	return a.Add(NewInputMediaVideo(media, caption))
+}
+
+// Audio appends an audio file.
+func (a *AlbumBuilder) Audio(media *InputFile, caption string) *AlbumBuilder {
+	return a.Add(NewInputMediaAudio(media, caption))
+}
+
+// Document appends a document.
+func (a *AlbumBuilder) Document(media *InputFile, caption string) *AlbumBuilder {
+	return a.Add(NewInputMediaDocument(media, caption))
+}
+
+// Add appends any media item.
+func (a *AlbumBuilder) Add(media InputMedia) *AlbumBuilder {
+	a.media = append(a.media, media)
+	return a
+}
+
+// Caption sets the caption of the first item, which Telegram clients show as the album caption.
+func (a *AlbumBuilder) Caption(caption string, parseMode ParseMode) *AlbumBuilder {
+	if len(a.media) == 0 {
+		return a
+	}
+	switch m := a.media[0].(type) {
+	case *InputMediaPhoto:
+		m.Caption, m.ParseMode = caption, parseMode
+	case *InputMediaVideo:
+		m.Caption, m.ParseMode = caption, parseMode
+	case *InputMediaAnimation:
+		m.Caption, m.ParseMode = caption, parseMode
+	case *InputMediaAudio:
+		m.Caption, m.ParseMode = caption, parseMode
+	case *InputMediaDocument:
+		m.Caption, m.ParseMode = caption, parseMode
+	}
+	return a
+}
+
+// Media returns the items added so far.
+func (a *AlbumBuilder) Media() []InputMedia {
+	return a.media
+}
+
+// Request returns a MediaGroupRequest sending the album to chatID.
+func (a *AlbumBuilder) Request(chatID any) *MediaGroupRequest {
+	return &MediaGroupRequest{ChatID: chatID, Media: a.media}
+}
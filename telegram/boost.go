@@ -0,0 +1,50 @@
+package telegram
+
+// ChatBoostSource describes the source of a chat boost.
+// @docs https://core.telegram.org/bots/api#chatboostsource
+type ChatBoostSource struct {
+	Source            string `json:"source"` // "premium" | "gift_code" | "giveaway"
+	User              *User  `json:"user,omitempty"`
+	GiveawayMessageID int64  `json:"giveaway_message_id,omitempty"` // "giveaway" only
+	PrizeStarCount    int    `json:"prize_star_count,omitempty"`    // "giveaway" only, for Telegram Star giveaways
+	IsUnclaimed       bool   `json:"is_unclaimed,omitempty"`        // "giveaway" only
+}
+
+// ChatBoost contains information about a chat boost.
+// @docs https://core.telegram.org/bots/api#chatboost
+type ChatBoost struct {
+	BoostID        string           `json:"boost_id"`
+	AddDate        int64            `json:"add_date"`
+	ExpirationDate int64            `json:"expiration_date"`
+	Source         *ChatBoostSource `json:"source"`
+}
+
+// ChatBoostUpdated represents a boost added to a chat or changed.
+// @docs https://core.telegram.org/bots/api#chatboostupdated
+type ChatBoostUpdated struct {
+	Chat  *Chat      `json:"chat"`
+	Boost *ChatBoost `json:"boost"`
+}
+
+// ChatBoostRemoved represents a boost removed from a chat.
+// @docs https://core.telegram.org/bots/api#chatboostremoved
+type ChatBoostRemoved struct {
+	Chat       *Chat            `json:"chat"`
+	BoostID    string           `json:"boost_id"`
+	RemoveDate int64            `json:"remove_date"`
+	Source     *ChatBoostSource `json:"source"`
+}
+
+// UserChatBoosts represents a list of boosts added to a chat by a user.
+// @docs https://core.telegram.org/bots/api#userchatboosts
+type UserChatBoosts struct {
+	Boosts []*ChatBoost `json:"boosts"`
+}
+
+// GetUserChatBoosts gets the list of boosts added to a chat by a user.
+// The bot must be an administrator in the chat.
+// https://core.telegram.org/bots/api#getuserchatboosts
+func (bot *TelegramBot) GetUserChatBoosts(chatID any, userID int64) (boosts *UserChatBoosts, err error) {
+	err = bot.CallMethod("getUserChatBoosts", &ChatMemberRequest{ChatID: chatID, UserID: userID}, &boosts)
+	return
+}
@@ -0,0 +1,282 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Broadcast statuses of a chat.
+const (
+	BroadcastSent        = "sent"
+	BroadcastSkipped     = "skipped"     // The message factory returned nil
+	BroadcastBlocked     = "blocked"     // The user blocked the bot, or the bot was removed from the chat
+	BroadcastDeactivated = "deactivated" // The user deleted their account
+	BroadcastNotFound    = "not_found"
+	BroadcastFailed      = "failed"
+	BroadcastRetry       = "retry" // A flood wait, server or network error, retried by the next run
+)
+
+// BroadcastResult is the outcome of a broadcast for a chat.
+type BroadcastResult struct {
+	ChatID    int64  `json:"chat_id"`
+	Status    string `json:"status"`
+	MessageID int64  `json:"message_id,omitempty"`
+	// The supergroup the chat was migrated to, where the message was sent instead
+	MigratedTo int64  `json:"migrated_to,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// BroadcastReport is the progress of a broadcast.
+type BroadcastReport struct {
+	Total   int                        `json:"total"`
+	Sent    int                        `json:"sent"`
+	Failed  int                        `json:"failed"` // Chats not sent to, including skipped ones
+	Retry   int                        `json:"retry"`  // Chats to retry, not counted as done
+	Results map[int64]*BroadcastResult `json:"results"`
+}
+
+// Done returns the number of chats processed for good so far.
+func (r *BroadcastReport) Done() int {
+	return r.Sent + r.Failed
+}
+
+// Failures returns the results of the chats the message wasn't sent to.
+func (r *BroadcastReport) Failures() (results []*BroadcastResult) {
+	for _, result := range r.Results {
+		if result.Status != BroadcastSent {
+			results = append(results, result)
+		}
+	}
+	return
+}
+
+// Broadcast sends a message to many chats within the Bot API limits,
+// keeping a checkpoint in a Store so that an interrupted run can be resumed:
+//
+//	b := telegram.NewBroadcast(bot, store, "release-1.2", func(chatID int64) *telegram.MessageRequest {
+//		return &telegram.MessageRequest{Text: "Version 1.2 is out!"}
+//	})
+//	b.OnProgress = func(r *telegram.BroadcastReport) { log.Printf("%d/%d", r.Done(), r.Total) }
+//	report, err := b.Run(ctx, tracker.ChatIDs())
+//
+// Running a broadcast again with the same ID skips the chats already processed,
+// except those which failed with a BroadcastRetry status.
+// Flood waits are retried, and messages to migrated groups are sent to their supergroup.
+type Broadcast struct {
+	bot     *TelegramBot
+	store   Store
+	id      string
+	message func(chatID int64) *MessageRequest
+	// Rate is the maximum number of messages sent per second, 25 by default,
+	// under the global limit of 30 messages per second.
+	Rate int
+	// ChatInterval is the minimum delay between two messages to a private chat,
+	// and GroupInterval to a group or channel. They default to 1 and 3 seconds.
+	ChatInterval  time.Duration
+	GroupInterval time.Duration
+	// MaxRetries is the number of flood waits retried for a chat, 3 by default.
+	MaxRetries int
+	// CheckpointEvery is the number of chats processed between two checkpoints, 20 by default.
+	CheckpointEvery int
+	// OnProgress, if set, is called after each chat.
+	OnProgress func(report *BroadcastReport)
+
+	next     time.Time
+	lastSent map[int64]time.Time
+}
+
+// NewBroadcast returns a broadcast of the messages returned by message for each chat,
+// identified by id in store. ChatID is set on the returned requests; returning nil skips the chat.
+func NewBroadcast(bot *TelegramBot, store Store, id string, message func(chatID int64) *MessageRequest) *Broadcast {
+	return &Broadcast{
+		bot:             bot,
+		store:           store,
+		id:              id,
+		message:         message,
+		Rate:            25,
+		ChatInterval:    time.Second,
+		GroupInterval:   3 * time.Second,
+		MaxRetries:      3,
+		CheckpointEvery: 20,
+	}
+}
+
+func (b *Broadcast) key() string {
+	return "broadcast:" + b.id
+}
+
+// Report returns the progress saved by the last checkpoint, or nil if the broadcast never ran.
+func (b *Broadcast) Report() (report *BroadcastReport, err error) {
+	data, ok, err := b.store.Get(b.key())
+	if err != nil || !ok {
+		return
+	}
+	err = json.Unmarshal(data, &report)
+	return
+}
+
+// Reset deletes the checkpoint, so that the next run sends to all the chats again.
+func (b *Broadcast) Reset() error {
+	return b.store.Delete(b.key())
+}
+
+func (b *Broadcast) save(report *BroadcastReport) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return b.store.Set(b.key(), data)
+}
+
+// Run sends the message to chatIDs, resuming from the last checkpoint.
+// It returns when all the chats are processed, or with the context error when ctx is done,
+// after saving a checkpoint.
+func (b *Broadcast) Run(ctx context.Context, chatIDs []int64) (report *BroadcastReport, err error) {
+	if report, err = b.Report(); err != nil {
+		return
+	}
+	if report == nil {
+		report = &BroadcastReport{}
+	}
+	if report.Results == nil {
+		report.Results = make(map[int64]*BroadcastResult)
+	}
+	report.Total = len(chatIDs)
+	b.lastSent = make(map[int64]time.Time)
+	pending := 0
+	defer func() {
+		if pending > 0 || err == nil {
+			if saveErr := b.save(report); err == nil {
+				err = saveErr
+			}
+		}
+	}()
+	for _, chatID := range chatIDs {
+		if result, ok := report.Results[chatID]; ok {
+			if result.Status != BroadcastRetry {
+				continue
+			}
+			report.Retry--
+		}
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var result *BroadcastResult
+		if result, err = b.send(ctx, chatID); err != nil {
+			return
+		}
+		report.Results[chatID] = result
+		switch result.Status {
+		case BroadcastSent:
+			report.Sent++
+		case BroadcastRetry:
+			report.Retry++
+		default:
+			report.Failed++
+		}
+		if pending++; pending >= b.CheckpointEvery {
+			if err = b.save(report); err != nil {
+				return
+			}
+			pending = 0
+		}
+		if b.OnProgress != nil {
+			b.OnProgress(report)
+		}
+	}
+	return
+}
+
+// send sends the message to a chat, only returning an error when ctx is done.
+func (b *Broadcast) send(ctx context.Context, chatID int64) (*BroadcastResult, error) {
+	result := &BroadcastResult{ChatID: chatID, Status: BroadcastSkipped}
+	message := b.message(chatID)
+	if message == nil {
+		return result, nil
+	}
+	req := b.bot.prepareMessage(message)
+	target := chatID
+	for retries := 0; ; {
+		if err := b.wait(ctx, target); err != nil {
+			return nil, err
+		}
+		req.ChatID = target
+		var sent *Message
+		err := b.bot.CallMethodContext(ctx, "sendMessage", req, &sent)
+		b.lastSent[target] = time.Now()
+		if err == nil {
+			result.Status, result.MessageID = BroadcastSent, sent.MessageID
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.Parameters != nil {
+			if to := apiErr.Parameters.MigrateToChatID; to != 0 && result.MigratedTo == 0 {
+				result.MigratedTo, target = to, to
+				continue
+			}
+			if wait := apiErr.Parameters.RetryAfter; wait > 0 && retries < b.MaxRetries {
+				retries++
+				if err := sleep(ctx, time.Duration(wait)*time.Second); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		result.Status, result.Error = broadcastStatus(err), err.Error()
+		return result, nil
+	}
+}
+
+func broadcastStatus(err error) string {
+	switch {
+	case isTemporary(err):
+		return BroadcastRetry
+	case errors.Is(err, ErrBotBlocked), errors.Is(err, ErrBotKicked):
+		return BroadcastBlocked
+	case errors.Is(err, ErrUserDeactivated):
+		return BroadcastDeactivated
+	case errors.Is(err, ErrChatNotFound):
+		return BroadcastNotFound
+	}
+	return BroadcastFailed
+}
+
+// wait waits for the global rate limit, and for the interval since the last message to the chat.
+func (b *Broadcast) wait(ctx context.Context, chatID int64) error {
+	now := time.Now()
+	at := b.next
+	interval := b.ChatInterval
+	if chatID < 0 {
+		interval = b.GroupInterval
+	}
+	if last, ok := b.lastSent[chatID]; ok && last.Add(interval).After(at) {
+		at = last.Add(interval)
+	}
+	if at.Before(now) {
+		at = now
+	}
+	if b.Rate > 0 {
+		b.next = at.Add(time.Second / time.Duration(b.Rate))
+	}
+	return sleep(ctx, time.Until(at))
+}
+
+// sleep waits for d, or returns the context error when ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,179 @@
+package telegram
+
+import "context"
+
+// MessageBuilder builds and sends a message with chainable calls:
+//
+//	bot.NewMessage(chatID).Text("hi").Silent().ReplyTo(msgID).Keyboard(kb).Send(ctx)
+//
+// A text message is sent, or a photo or document when one is set, in which
+// case the text becomes its caption.
+type MessageBuilder struct {
+	bot         *TelegramBot
+	business    string
+	chatID      any
+	threadID    int64
+	text        string
+	parseMode   ParseMode
+	entities    []*MessageEntity
+	photo       *InputFile
+	document    *InputFile
+	silent      bool
+	protected   bool
+	spoiler     bool
+	reply       *ReplyParameters
+	markup      ReplyMarkup
+	linkPreview *LinkPreviewOptions
+}
+
+// NewMessage starts building a message to chatID.
+func (bot *TelegramBot) NewMessage(chatID any) *MessageBuilder {
+	return &MessageBuilder{bot: bot, chatID: chatID}
+}
+
+// Text sets the text of the message, or the caption of a photo or document.
+func (b *MessageBuilder) Text(text string) *MessageBuilder {
+	b.text, b.entities = text, nil
+	return b
+}
+
+// Styled sets the text along with its entities, see StyledText.
+func (b *MessageBuilder) Styled(text *StyledText) *MessageBuilder {
+	b.text, b.entities, b.parseMode = text.Text, text.Entities, ""
+	return b
+}
+
+func (b *MessageBuilder) ParseMode(mode ParseMode) *MessageBuilder {
+	b.parseMode = mode
+	return b
+}
+
+// HTML parses the text as ParseModeHTML.
+func (b *MessageBuilder) HTML() *MessageBuilder {
+	return b.ParseMode(ParseModeHTML)
+}
+
+// MarkdownV2 parses the text as ParseModeMarkdownV2.
+func (b *MessageBuilder) MarkdownV2() *MessageBuilder {
+	return b.ParseMode(ParseModeMarkdownV2)
+}
+
+// Photo sends a photo with the text as caption.
+func (b *MessageBuilder) Photo(photo *InputFile) *MessageBuilder {
+	b.photo, b.document = photo, nil
+	return b
+}
+
+// Document sends a document with the text as caption.
+func (b *MessageBuilder) Document(document *InputFile) *MessageBuilder {
+	b.document, b.photo = document, nil
+	return b
+}
+
+// Thread sends the message to a forum topic.
+func (b *MessageBuilder) Thread(threadID int64) *MessageBuilder {
+	b.threadID = threadID
+	return b
+}
+
+// Business sends the message on behalf of the business account of a connection.
+func (b *MessageBuilder) Business(connectionID string) *MessageBuilder {
+	b.business = connectionID
+	return b
+}
+
+// Silent sends the message without sound.
+func (b *MessageBuilder) Silent() *MessageBuilder {
+	b.silent = true
+	return b
+}
+
+// Protected protects the message from forwarding and saving.
+func (b *MessageBuilder) Protected() *MessageBuilder {
+	b.protected = true
+	return b
+}
+
+// Spoiler covers a photo with a spoiler animation.
+func (b *MessageBuilder) Spoiler() *MessageBuilder {
+	b.spoiler = true
+	return b
+}
+
+// ReplyTo sends the message as a reply to messageID in the same chat.
+func (b *MessageBuilder) ReplyTo(messageID int64) *MessageBuilder {
+	b.reply = &ReplyParameters{MessageID: messageID}
+	return b
+}
+
+// Reply sets the reply parameters, e.g. to quote or reply in another chat.
+func (b *MessageBuilder) Reply(reply *ReplyParameters) *MessageBuilder {
+	b.reply = reply
+	return b
+}
+
+// Keyboard attaches an inline keyboard or reply keyboard to the message.
+func (b *MessageBuilder) Keyboard(markup ReplyMarkup) *MessageBuilder {
+	b.markup = markup
+	return b
+}
+
+// NoPreview disables the link preview of a text message.
+func (b *MessageBuilder) NoPreview() *MessageBuilder {
+	b.linkPreview = &LinkPreviewOptions{IsDisabled: true}
+	return b
+}
+
+// Send sends the message.
+func (b *MessageBuilder) Send(ctx context.Context) (result *Message, err error) {
+	switch {
+	case b.photo != nil:
+		err = b.bot.CallMethodContext(ctx, "sendPhoto", &PhotoRequest{
+			BusinessConnectionID: b.business,
+			ChatID:               b.chatID,
+			MessageThreadID:      b.threadID,
+			Photo:                b.photo,
+			Caption:              b.text,
+			ParseMode:            b.parseMode,
+			CaptionEntities:      b.entities,
+			HasSpoiler:           b.spoiler,
+			DisableNotification:  b.silent,
+			ProtectContent:       b.protected,
+			ReplyParameters:      b.reply,
+			ReplyMarkup:          b.markup,
+		}, &result)
+	case b.document != nil:
+		err = b.bot.CallMethodContext(ctx, "sendDocument", &DocumentRequest{
+			BusinessConnectionID: b.business,
+			ChatID:               b.chatID,
+			MessageThreadID:      b.threadID,
+			Document:             b.document,
+			Caption:              b.text,
+			ParseMode:            b.parseMode,
+			CaptionEntities:      b.entities,
+			DisableNotification:  b.silent,
+			ProtectContent:       b.protected,
+			ReplyParameters:      b.reply,
+			ReplyMarkup:          b.markup,
+		}, &result)
+	default:
+		linkPreview := b.linkPreview
+		if linkPreview == nil {
+			linkPreview = b.bot.config.LinkPreviewOptions
+		}
+		err = b.bot.CallMethodContext(ctx, "sendMessage", &MessageRequest{
+			BusinessConnectionID: b.business,
+			ChatID:               b.chatID,
+			MessageThreadID:      b.threadID,
+			Text:                 b.text,
+			ParseMode:            b.parseMode,
+			Entities:             b.entities,
+			LinkPreviewOptions:   linkPreview,
+			DisableNotification:  b.silent,
+			ProtectContent:       b.protected,
+			ReplyParameters:      b.reply,
+			ReplyMarkup:          b.markup,
+		}, &result)
+	}
+	return
+}
@@ -0,0 +1,113 @@
+package telegram
+
+// BusinessBotRights represents the rights of a business bot.
+// @docs https://core.telegram.org/bots/api#businessbotrights
+type BusinessBotRights struct {
+	CanReply                   bool `json:"can_reply,omitempty"`
+	CanReadMessages            bool `json:"can_read_messages,omitempty"`
+	CanDeleteSentMessages      bool `json:"can_delete_sent_messages,omitempty"`
+	CanDeleteAllMessages       bool `json:"can_delete_all_messages,omitempty"`
+	CanEditName                bool `json:"can_edit_name,omitempty"`
+	CanEditBio                 bool `json:"can_edit_bio,omitempty"`
+	CanEditProfilePhoto        bool `json:"can_edit_profile_photo,omitempty"`
+	CanEditUsername            bool `json:"can_edit_username,omitempty"`
+	CanChangeGiftSettings      bool `json:"can_change_gift_settings,omitempty"`
+	CanViewGiftsAndStars       bool `json:"can_view_gifts_and_stars,omitempty"`
+	CanConvertGiftsToStars     bool `json:"can_convert_gifts_to_stars,omitempty"`
+	CanTransferAndUpgradeGifts bool `json:"can_transfer_and_upgrade_gifts,omitempty"`
+	CanTransferStars           bool `json:"can_transfer_stars,omitempty"`
+	CanManageStories           bool `json:"can_manage_stories,omitempty"`
+}
+
+// BusinessConnection describes the connection of the bot with a business account.
+// @docs https://core.telegram.org/bots/api#businessconnection
+type BusinessConnection struct {
+	ID         string             `json:"id"`
+	User       *User              `json:"user"`
+	UserChatID int64              `json:"user_chat_id"`
+	Date       int64              `json:"date"`
+	Rights     *BusinessBotRights `json:"rights,omitempty"`
+	IsEnabled  bool               `json:"is_enabled"`
+}
+
+// BusinessMessagesDeleted is received when messages are deleted from a connected business account.
+// @docs https://core.telegram.org/bots/api#businessmessagesdeleted
+type BusinessMessagesDeleted struct {
+	BusinessConnectionID string  `json:"business_connection_id"`
+	Chat                 *Chat   `json:"chat"`
+	MessageIDs           []int64 `json:"message_ids"`
+}
+
+type BusinessConnectionRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+}
+
+// GetBusinessConnection returns information about the connection of the bot with a business account.
+// https://core.telegram.org/bots/api#getbusinessconnection
+func (bot *TelegramBot) GetBusinessConnection(businessConnectionID string) (connection *BusinessConnection, err error) {
+	err = bot.CallMethod("getBusinessConnection", &BusinessConnectionRequest{BusinessConnectionID: businessConnectionID}, &connection)
+	return
+}
+
+type ReadBusinessMessageRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	ChatID               int64  `json:"chat_id"` // The chat must have been active in the last 24 hours
+	MessageID            int64  `json:"message_id"`
+}
+
+// ReadBusinessMessage marks an incoming message as read on behalf of a business account.
+// Requires the CanReadMessages business bot right.
+// https://core.telegram.org/bots/api#readbusinessmessage
+func (bot *TelegramBot) ReadBusinessMessage(businessConnectionID string, chatID, messageID int64) error {
+	return bot.CallMethod("readBusinessMessage", &ReadBusinessMessageRequest{
+		BusinessConnectionID: businessConnectionID,
+		ChatID:               chatID,
+		MessageID:            messageID,
+	}, nil)
+}
+
+type DeleteBusinessMessagesRequest struct {
+	BusinessConnectionID string  `json:"business_connection_id"`
+	MessageIDs           []int64 `json:"message_ids"` // 1-100 messages, all from the same chat
+}
+
+// DeleteBusinessMessages deletes messages on behalf of a business account.
+// Requires the CanDeleteSentMessages business bot right to delete messages sent by the bot itself,
+// or the CanDeleteAllMessages business bot right to delete any message.
+// https://core.telegram.org/bots/api#deletebusinessmessages
+func (bot *TelegramBot) DeleteBusinessMessages(businessConnectionID string, messageIDs []int64) error {
+	return bot.CallMethod("deleteBusinessMessages", &DeleteBusinessMessagesRequest{
+		BusinessConnectionID: businessConnectionID,
+		MessageIDs:           messageIDs,
+	}, nil)
+}
+
+// BusinessIntro contains information about the start page settings of a Telegram Business account.
+// @docs https://core.telegram.org/bots/api#businessintro
+type BusinessIntro struct {
+	Title   string   `json:"title,omitempty"`
+	Message string   `json:"message,omitempty"`
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+// BusinessLocation contains information about the location of a Telegram Business account.
+// @docs https://core.telegram.org/bots/api#businesslocation
+type BusinessLocation struct {
+	Address  string    `json:"address"`
+	Location *Location `json:"location,omitempty"`
+}
+
+// BusinessOpeningHoursInterval describes an interval of time during which a business is open.
+// Minutes are counted from the start of the week, Monday 00:00, in the range 0 - 7 * 24 * 60.
+// @docs https://core.telegram.org/bots/api#businessopeninghoursinterval
+type BusinessOpeningHoursInterval struct {
+	OpeningMinute int `json:"opening_minute"`
+	ClosingMinute int `json:"closing_minute"`
+}
+
+// BusinessOpeningHours describes the opening hours of a business.
+// @docs https://core.telegram.org/bots/api#businessopeninghours
+type BusinessOpeningHours struct {
+	TimeZoneName string                          `json:"time_zone_name"`
+	OpeningHours []*BusinessOpeningHoursInterval `json:"opening_hours"`
+}
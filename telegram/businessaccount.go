@@ -0,0 +1,213 @@
+package telegram
+
+// InputProfilePhoto describes a profile photo to set,
+// one of InputProfilePhotoStatic or InputProfilePhotoAnimated.
+// @docs https://core.telegram.org/bots/api#inputprofilephoto
+type InputProfilePhoto interface {
+	ProfilePhotoType() string
+}
+
+// InputProfilePhotoStatic is a static profile photo in the .JPG format.
+// @docs https://core.telegram.org/bots/api#inputprofilephotostatic
+type InputProfilePhotoStatic struct {
+	Photo *InputFile `json:"photo"` // Must be uploaded
+}
+
+// InputProfilePhotoAnimated is an animated profile photo in the MPEG4 format.
+// @docs https://core.telegram.org/bots/api#inputprofilephotoanimated
+type InputProfilePhotoAnimated struct {
+	Animation          *InputFile `json:"animation"`                      // Must be uploaded
+	MainFrameTimestamp float64    `json:"main_frame_timestamp,omitempty"` // Seconds of the frame used as static photo
+}
+
+func (p *InputProfilePhotoStatic) ProfilePhotoType() string   { return "static" }
+func (p *InputProfilePhotoAnimated) ProfilePhotoType() string { return "animated" }
+
+func (p *InputProfilePhotoStatic) MarshalJSON() ([]byte, error) {
+	type alias InputProfilePhotoStatic
+	return marshalUnion(p.ProfilePhotoType(), (*alias)(p))
+}
+
+func (p *InputProfilePhotoAnimated) MarshalJSON() ([]byte, error) {
+	type alias InputProfilePhotoAnimated
+	return marshalUnion(p.ProfilePhotoType(), (*alias)(p))
+}
+
+type BusinessAccountNameRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	FirstName            string `json:"first_name"` // 1-64 characters
+	LastName             string `json:"last_name,omitempty"`
+}
+
+// SetBusinessAccountName changes the first and last name of a managed business account.
+// Requires the CanEditName business bot right.
+// https://core.telegram.org/bots/api#setbusinessaccountname
+func (bot *TelegramBot) SetBusinessAccountName(businessConnectionID, firstName, lastName string) error {
+	return bot.CallMethod("setBusinessAccountName", &BusinessAccountNameRequest{
+		BusinessConnectionID: businessConnectionID,
+		FirstName:            firstName,
+		LastName:             lastName,
+	}, nil)
+}
+
+type BusinessAccountUsernameRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	Username             string `json:"username,omitempty"` // 0-32 characters
+}
+
+// SetBusinessAccountUsername changes the username of a managed business account, or removes it if empty.
+// Requires the CanEditUsername business bot right.
+// https://core.telegram.org/bots/api#setbusinessaccountusername
+func (bot *TelegramBot) SetBusinessAccountUsername(businessConnectionID, username string) error {
+	return bot.CallMethod("setBusinessAccountUsername", &BusinessAccountUsernameRequest{
+		BusinessConnectionID: businessConnectionID,
+		Username:             username,
+	}, nil)
+}
+
+type BusinessAccountBioRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	Bio                  string `json:"bio,omitempty"` // 0-140 characters
+}
+
+// SetBusinessAccountBio changes the bio of a managed business account, or removes it if empty.
+// Requires the CanEditBio business bot right.
+// https://core.telegram.org/bots/api#setbusinessaccountbio
+func (bot *TelegramBot) SetBusinessAccountBio(businessConnectionID, bio string) error {
+	return bot.CallMethod("setBusinessAccountBio", &BusinessAccountBioRequest{
+		BusinessConnectionID: businessConnectionID,
+		Bio:                  bio,
+	}, nil)
+}
+
+type BusinessAccountProfilePhotoRequest struct {
+	BusinessConnectionID string            `json:"business_connection_id"`
+	Photo                InputProfilePhoto `json:"photo,omitempty"`
+	// Set or remove the public photo, visible even if the main photo is hidden by privacy settings
+	IsPublic bool `json:"is_public,omitempty"`
+}
+
+// SetBusinessAccountProfilePhoto changes the profile photo of a managed business account.
+// Requires the CanEditProfilePhoto business bot right.
+// https://core.telegram.org/bots/api#setbusinessaccountprofilephoto
+func (bot *TelegramBot) SetBusinessAccountProfilePhoto(req *BusinessAccountProfilePhotoRequest) error {
+	return bot.CallMethod("setBusinessAccountProfilePhoto", req, nil)
+}
+
+// RemoveBusinessAccountProfilePhoto removes the current profile photo of a managed business account,
+// or its public photo if isPublic is set.
+// Requires the CanEditProfilePhoto business bot right.
+// https://core.telegram.org/bots/api#removebusinessaccountprofilephoto
+func (bot *TelegramBot) RemoveBusinessAccountProfilePhoto(businessConnectionID string, isPublic bool) error {
+	return bot.CallMethod("removeBusinessAccountProfilePhoto", &BusinessAccountProfilePhotoRequest{
+		BusinessConnectionID: businessConnectionID,
+		IsPublic:             isPublic,
+	}, nil)
+}
+
+type BusinessAccountGiftSettingsRequest struct {
+	BusinessConnectionID string             `json:"business_connection_id"`
+	ShowGiftButton       bool               `json:"show_gift_button"` // Always show the gift button in the input field
+	AcceptedGiftTypes    *AcceptedGiftTypes `json:"accepted_gift_types"`
+}
+
+// SetBusinessAccountGiftSettings changes the privacy settings pertaining to incoming gifts
+// of a managed business account.
+// Requires the CanChangeGiftSettings business bot right.
+// https://core.telegram.org/bots/api#setbusinessaccountgiftsettings
+func (bot *TelegramBot) SetBusinessAccountGiftSettings(req *BusinessAccountGiftSettingsRequest) error {
+	return bot.CallMethod("setBusinessAccountGiftSettings", req, nil)
+}
+
+// GetBusinessAccountStarBalance returns the amount of Telegram Stars owned by a managed business account.
+// Requires the CanViewGiftsAndStars business bot right.
+// https://core.telegram.org/bots/api#getbusinessaccountstarbalance
+func (bot *TelegramBot) GetBusinessAccountStarBalance(businessConnectionID string) (balance *StarAmount, err error) {
+	err = bot.CallMethod("getBusinessAccountStarBalance", &BusinessConnectionRequest{BusinessConnectionID: businessConnectionID}, &balance)
+	return
+}
+
+type TransferBusinessAccountStarsRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	StarCount            int    `json:"star_count"` // 1-10000
+}
+
+// TransferBusinessAccountStars transfers Telegram Stars from a managed business account to the bot's balance.
+// Requires the CanTransferStars business bot right.
+// https://core.telegram.org/bots/api#transferbusinessaccountstars
+func (bot *TelegramBot) TransferBusinessAccountStars(businessConnectionID string, starCount int) error {
+	return bot.CallMethod("transferBusinessAccountStars", &TransferBusinessAccountStarsRequest{
+		BusinessConnectionID: businessConnectionID,
+		StarCount:            starCount,
+	}, nil)
+}
+
+type BusinessAccountGiftsRequest struct {
+	BusinessConnectionID        string `json:"business_connection_id"`
+	ExcludeUnsaved              bool   `json:"exclude_unsaved,omitempty"`
+	ExcludeSaved                bool   `json:"exclude_saved,omitempty"`
+	ExcludeUnlimited            bool   `json:"exclude_unlimited,omitempty"`
+	ExcludeLimitedUpgradable    bool   `json:"exclude_limited_upgradable,omitempty"`
+	ExcludeLimitedNonUpgradable bool   `json:"exclude_limited_non_upgradable,omitempty"`
+	ExcludeUnique               bool   `json:"exclude_unique,omitempty"`
+	ExcludeFromBlockchain       bool   `json:"exclude_from_blockchain,omitempty"`
+	SortByPrice                 bool   `json:"sort_by_price,omitempty"` // Instead of sending date
+	Offset                      string `json:"offset,omitempty"`        // OwnedGifts.NextOffset of the previous page
+	Limit                       int    `json:"limit,omitempty"`         // 1-100, defaults to 100
+}
+
+// GetBusinessAccountGifts returns the gifts received and owned by a managed business account.
+// Requires the CanViewGiftsAndStars business bot right.
+// https://core.telegram.org/bots/api#getbusinessaccountgifts
+func (bot *TelegramBot) GetBusinessAccountGifts(req *BusinessAccountGiftsRequest) (gifts *OwnedGifts, err error) {
+	err = bot.CallMethod("getBusinessAccountGifts", req, &gifts)
+	return
+}
+
+type OwnedGiftRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	OwnedGiftID          string `json:"owned_gift_id"`
+}
+
+// ConvertGiftToStars converts a regular gift owned by a managed business account to Telegram Stars.
+// Requires the CanConvertGiftsToStars business bot right.
+// https://core.telegram.org/bots/api#convertgifttostars
+func (bot *TelegramBot) ConvertGiftToStars(businessConnectionID, ownedGiftID string) error {
+	return bot.CallMethod("convertGiftToStars", &OwnedGiftRequest{
+		BusinessConnectionID: businessConnectionID,
+		OwnedGiftID:          ownedGiftID,
+	}, nil)
+}
+
+type UpgradeGiftRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	OwnedGiftID          string `json:"owned_gift_id"`
+	// Keep the original gift text, sender and receiver in the upgraded gift
+	KeepOriginalDetails bool `json:"keep_original_details,omitempty"`
+	// Stars paid from the business account balance for the upgrade, if it isn't prepaid;
+	// must be Gift.UpgradeStarCount
+	StarCount int `json:"star_count,omitempty"`
+}
+
+// UpgradeGift upgrades a regular gift owned by a managed business account to a unique gift.
+// Requires the CanTransferAndUpgradeGifts business bot right, and CanTransferStars if the upgrade is paid.
+// https://core.telegram.org/bots/api#upgradegift
+func (bot *TelegramBot) UpgradeGift(req *UpgradeGiftRequest) error {
+	return bot.CallMethod("upgradeGift", req, nil)
+}
+
+type TransferGiftRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	OwnedGiftID          string `json:"owned_gift_id"`
+	// Chat which will own the gift, which must be active in the last 24 hours
+	NewOwnerChatID int64 `json:"new_owner_chat_id"`
+	// Stars paid from the business account balance for the transfer, must be OwnedGift.TransferStarCount
+	StarCount int `json:"star_count,omitempty"`
+}
+
+// TransferGift transfers a unique gift owned by a managed business account to another user.
+// Requires the CanTransferAndUpgradeGifts business bot right, and CanTransferStars if the transfer is paid.
+// https://core.telegram.org/bots/api#transfergift
+func (bot *TelegramBot) TransferGift(req *TransferGiftRequest) error {
+	return bot.CallMethod("transferGift", req, nil)
+}
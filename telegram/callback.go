@@ -0,0 +1,39 @@
+package telegram
+
+// CallbackQuery represents an incoming callback query from a callback button in an inline keyboard.
+// @docs https://core.telegram.org/bots/api#callbackquery
+type CallbackQuery struct {
+	ID   string `json:"id"`
+	From *User  `json:"from"`
+	// Message sent by the bot with the callback button that originated the query, see Message.IsAccessible
+	Message *MaybeInaccessibleMessage `json:"message,omitempty"`
+	// Identifier of the message sent via the bot in inline mode, that originated the query
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// Global identifier, uniquely corresponding to the chat to which the message with the callback button was sent
+	ChatInstance  string `json:"chat_instance"`
+	Data          string `json:"data,omitempty"`
+	GameShortName string `json:"game_short_name,omitempty"`
+}
+
+type AnswerCallbackQueryRequest struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`       // 0-200 characters
+	ShowAlert       bool   `json:"show_alert,omitempty"` // Show an alert instead of a notification at the top of the chat screen
+	// URL opened by the user's client, for a game (see CallbackQuery.GameShortName)
+	// or a t.me/your_bot?start=XXXX link opening the bot with a parameter
+	URL       string `json:"url,omitempty"`
+	CacheTime int    `json:"cache_time,omitempty"` // Seconds the result may be cached client-side
+}
+
+// AnswerCallbackQuery sends an answer to a callback query. The answer will be displayed to the user
+// as a notification at the top of the chat screen or as an alert.
+// Clients display a progress bar until the query is answered, so it must be answered even without text.
+// https://core.telegram.org/bots/api#answercallbackquery
+func (bot *TelegramBot) AnswerCallbackQuery(req *AnswerCallbackQueryRequest) error {
+	return bot.CallMethod("answerCallbackQuery", req, nil)
+}
+
+// AnswerCallback answers query with a notification text, which may be empty.
+func (bot *TelegramBot) AnswerCallback(query *CallbackQuery, text string) error {
+	return bot.AnswerCallbackQuery(&AnswerCallbackQueryRequest{CallbackQueryID: query.ID, Text: text})
+}
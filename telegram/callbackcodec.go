@@ -0,0 +1,133 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxCallbackDataLength is the maximum size of callback data, in bytes.
+const MaxCallbackDataLength = 64
+
+// callbackSeparator separates the prefix of callback data from its payload.
+const callbackSeparator = ":"
+
+// CallbackCodec encodes payloads into callback data and decodes them back.
+type CallbackCodec interface {
+	Encode(payload any) (string, error)
+	Decode(data string, payload any) error
+}
+
+// DefaultCallbackCodec encodes strings and integers as is and other values as JSON.
+var DefaultCallbackCodec CallbackCodec = defaultCallbackCodec{}
+
+type defaultCallbackCodec struct{}
+
+func (defaultCallbackCodec) Encode(payload any) (string, error) {
+	switch p := payload.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return p, nil
+	case int:
+		return strconv.Itoa(p), nil
+	case int64:
+		return strconv.FormatInt(p, 10), nil
+	}
+	data, err := json.Marshal(payload)
+	return string(data), err
+}
+
+func (defaultCallbackCodec) Decode(data string, payload any) (err error) {
+	switch p := payload.(type) {
+	case *string:
+		*p = data
+	case *int:
+		*p, err = strconv.Atoi(data)
+	case *int64:
+		*p, err = strconv.ParseInt(data, 10, 64)
+	default:
+		if data != "" {
+			err = json.Unmarshal([]byte(data), payload)
+		}
+	}
+	return
+}
+
+// EncodeCallback returns the callback data for payload routed to the handler of prefix,
+// see OnCallback. It fails if prefix contains ":" or the data exceeds MaxCallbackDataLength.
+func EncodeCallback(codec CallbackCodec, prefix string, payload any) (string, error) {
+	if strings.Contains(prefix, callbackSeparator) {
+		return "", fmt.Errorf("telegram: callback prefix %q contains %q", prefix, callbackSeparator)
+	}
+	if codec == nil {
+		codec = DefaultCallbackCodec
+	}
+	encoded, err := codec.Encode(payload)
+	if err != nil {
+		return "", err
+	}
+	data := prefix
+	if encoded != "" {
+		data += callbackSeparator + encoded
+	}
+	if len(data) > MaxCallbackDataLength {
+		return "", fmt.Errorf("telegram: callback data %q exceeds %d bytes", data, MaxCallbackDataLength)
+	}
+	return data, nil
+}
+
+// splitCallback splits callback data into its prefix and payload.
+func splitCallback(data string) (prefix, payload string) {
+	prefix, payload, _ = strings.Cut(data, callbackSeparator)
+	return
+}
+
+// CallbackData returns the callback data for payload routed to the handler
+// registered with OnCallback for prefix, using the router's codec.
+func (r *Router) CallbackData(prefix string, payload any) (string, error) {
+	return EncodeCallback(r.Codec, prefix, payload)
+}
+
+// CallbackButton returns a callback button for payload routed to the handler of prefix.
+func (r *Router) CallbackButton(text, prefix string, payload any) (*InlineKeyboardButton, error) {
+	data, err := r.CallbackData(prefix, payload)
+	if err != nil {
+		return nil, err
+	}
+	return CallbackButton(text, data), nil
+}
+
+// OnCallback handles the callback queries whose data was created by
+// Router.CallbackData for prefix, calling fn with the decoded payload and the
+// message of the button, nil for inline messages or inaccessible ones:
+//
+//	telegram.OnCallback(router, "vote", func(q *telegram.CallbackQuery, msg *telegram.Message, option int) error {
+//		...
+//	})
+func OnCallback[T any](r *Router, prefix string, fn func(query *CallbackQuery, message *Message, payload T) error) {
+	r.handle(func(update *Update) (bool, error) {
+		query := update.CallbackQuery
+		if query == nil {
+			return false, nil
+		}
+		p, data := splitCallback(query.Data)
+		if p != prefix {
+			return false, nil
+		}
+		codec := r.Codec
+		if codec == nil {
+			codec = DefaultCallbackCodec
+		}
+		var payload T
+		if err := codec.Decode(data, &payload); err != nil {
+			return true, fmt.Errorf("telegram: callback %q: %w", query.Data, err)
+		}
+		message := query.Message
+		if message != nil && !message.IsAccessible() {
+			message = nil
+		}
+		return true, fn(query, message, payload)
+	})
+}
@@ -0,0 +1,484 @@
+package telegram
+
+import "time"
+
+// ChatAdministratorRights represents the rights of an administrator in a chat.
+// @docs https://core.telegram.org/bots/api#chatadministratorrights
+type ChatAdministratorRights struct {
+	IsAnonymous             bool `json:"is_anonymous"`
+	CanManageChat           bool `json:"can_manage_chat"`
+	CanDeleteMessages       bool `json:"can_delete_messages"`
+	CanManageVideoChats     bool `json:"can_manage_video_chats"`
+	CanRestrictMembers      bool `json:"can_restrict_members"`
+	CanPromoteMembers       bool `json:"can_promote_members"`
+	CanChangeInfo           bool `json:"can_change_info"`
+	CanInviteUsers          bool `json:"can_invite_users"`
+	CanPostStories          bool `json:"can_post_stories"`
+	CanEditStories          bool `json:"can_edit_stories"`
+	CanDeleteStories        bool `json:"can_delete_stories"`
+	CanPostMessages         bool `json:"can_post_messages,omitempty"`          // Channels only
+	CanEditMessages         bool `json:"can_edit_messages,omitempty"`          // Channels only
+	CanPinMessages          bool `json:"can_pin_messages,omitempty"`           // Groups and supergroups only
+	CanManageTopics         bool `json:"can_manage_topics,omitempty"`          // Supergroups only
+	CanManageDirectMessages bool `json:"can_manage_direct_messages,omitempty"` // Channels only
+}
+
+// ChatPermissions describes actions that a non-administrator user is allowed to take in a chat.
+// @docs https://core.telegram.org/bots/api#chatpermissions
+type ChatPermissions struct {
+	CanSendMessages       bool `json:"can_send_messages,omitempty"`
+	CanSendAudios         bool `json:"can_send_audios,omitempty"`
+	CanSendDocuments      bool `json:"can_send_documents,omitempty"`
+	CanSendPhotos         bool `json:"can_send_photos,omitempty"`
+	CanSendVideos         bool `json:"can_send_videos,omitempty"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes,omitempty"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes,omitempty"`
+	CanSendPolls          bool `json:"can_send_polls,omitempty"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages,omitempty"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+	CanChangeInfo         bool `json:"can_change_info,omitempty"`
+	CanInviteUsers        bool `json:"can_invite_users,omitempty"`
+	CanPinMessages        bool `json:"can_pin_messages,omitempty"`
+	CanManageTopics       bool `json:"can_manage_topics,omitempty"`
+}
+
+// Birthdate describes the birthdate of a user.
+// @docs https://core.telegram.org/bots/api#birthdate
+type Birthdate struct {
+	Day   int `json:"day"`
+	Month int `json:"month"`
+	Year  int `json:"year,omitempty"`
+}
+
+// ChatLocation represents a location to which a chat is connected.
+// @docs https://core.telegram.org/bots/api#chatlocation
+type ChatLocation struct {
+	Location *Location `json:"location"`
+	Address  string    `json:"address"`
+}
+
+// AcceptedGiftTypes describes the types of gifts that can be gifted to a user or a chat.
+// @docs https://core.telegram.org/bots/api#acceptedgifttypes
+type AcceptedGiftTypes struct {
+	UnlimitedGifts      bool `json:"unlimited_gifts"`
+	LimitedGifts        bool `json:"limited_gifts"`
+	UniqueGifts         bool `json:"unique_gifts"`
+	PremiumSubscription bool `json:"premium_subscription"`
+}
+
+// ChatFullInfo contains full information about a chat, as returned by GetChat.
+// @docs https://core.telegram.org/bots/api#chatfullinfo
+type ChatFullInfo struct {
+	ID                                 int64                 `json:"id"`
+	Type                               ChatType              `json:"type"`
+	Title                              string                `json:"title,omitempty"`
+	UserName                           string                `json:"username,omitempty"`
+	FirstName                          string                `json:"first_name,omitempty"`
+	LastName                           string                `json:"last_name,omitempty"`
+	IsForum                            bool                  `json:"is_forum,omitempty"`
+	IsDirectMessages                   bool                  `json:"is_direct_messages,omitempty"`
+	AccentColorID                      int                   `json:"accent_color_id"`
+	MaxReactionCount                   int                   `json:"max_reaction_count"`
+	Photo                              *ChatPhoto            `json:"photo,omitempty"`
+	ActiveUserNames                    []string              `json:"active_usernames,omitempty"`
+	Birthdate                          *Birthdate            `json:"birthdate,omitempty"`
+	BusinessIntro                      *BusinessIntro        `json:"business_intro,omitempty"`
+	BusinessLocation                   *BusinessLocation     `json:"business_location,omitempty"`
+	BusinessOpeningHours               *BusinessOpeningHours `json:"business_opening_hours,omitempty"`
+	PersonalChat                       *Chat                 `json:"personal_chat,omitempty"`
+	ParentChat                         *Chat                 `json:"parent_chat,omitempty"`
+	AvailableReactions                 []*ReactionType       `json:"available_reactions,omitempty"`
+	BackgroundCustomEmojiID            string                `json:"background_custom_emoji_id,omitempty"`
+	ProfileAccentColorID               int                   `json:"profile_accent_color_id,omitempty"`
+	ProfileBackgroundCustomEmojiID     string                `json:"profile_background_custom_emoji_id,omitempty"`
+	EmojiStatusCustomEmojiID           string                `json:"emoji_status_custom_emoji_id,omitempty"`
+	EmojiStatusExpirationDate          int64                 `json:"emoji_status_expiration_date,omitempty"`
+	Bio                                string                `json:"bio,omitempty"`
+	HasPrivateForwards                 bool                  `json:"has_private_forwards,omitempty"`
+	HasRestrictedVoiceAndVideoMessages bool                  `json:"has_restricted_voice_and_video_messages,omitempty"`
+	JoinToSendMessages                 bool                  `json:"join_to_send_messages,omitempty"`
+	JoinByRequest                      bool                  `json:"join_by_request,omitempty"`
+	Description                        string                `json:"description,omitempty"`
+	InviteLink                         string                `json:"invite_link,omitempty"`
+	PinnedMessage                      *Message              `json:"pinned_message,omitempty"`
+	Permissions                        *ChatPermissions      `json:"permissions,omitempty"`
+	AcceptedGiftTypes                  *AcceptedGiftTypes    `json:"accepted_gift_types,omitempty"`
+	CanSendPaidMedia                   bool                  `json:"can_send_paid_media,omitempty"`
+	SlowModeDelay                      int                   `json:"slow_mode_delay,omitempty"`
+	UnrestrictBoostCount               int                   `json:"unrestrict_boost_count,omitempty"`
+	MessageAutoDeleteTime              int                   `json:"message_auto_delete_time,omitempty"`
+	HasAggressiveAntiSpamEnabled       bool                  `json:"has_aggressive_anti_spam_enabled,omitempty"`
+	HasHiddenMembers                   bool                  `json:"has_hidden_members,omitempty"`
+	HasProtectedContent                bool                  `json:"has_protected_content,omitempty"`
+	HasVisibleHistory                  bool                  `json:"has_visible_history,omitempty"`
+	StickerSetName                     string                `json:"sticker_set_name,omitempty"`
+	CanSetStickerSet                   bool                  `json:"can_set_sticker_set,omitempty"`
+	CustomEmojiStickerSetName          string                `json:"custom_emoji_sticker_set_name,omitempty"`
+	LinkedChatID                       int64                 `json:"linked_chat_id,omitempty"`
+	Location                           *ChatLocation         `json:"location,omitempty"`
+	PaidMessageStarCount               int                   `json:"paid_message_star_count,omitempty"`
+}
+
+// ChatMember contains information about one member of a chat.
+// Status tells which of the fields are set:
+//   - "creator": IsAnonymous, CustomTitle
+//   - "administrator": CanBeEdited, IsAnonymous, CustomTitle and the administrator rights
+//   - "member": UntilDate, for the end of a subscription
+//   - "restricted": IsMember, UntilDate and the permissions of ChatPermissions
+//   - "left": no additional fields
+//   - "kicked": UntilDate
+//
+// @docs https://core.telegram.org/bots/api#chatmember
+type ChatMember struct {
+	Status      ChatMemberStatus `json:"status"`
+	User        *User            `json:"user"`
+	IsAnonymous bool             `json:"is_anonymous,omitempty"`
+	CustomTitle string           `json:"custom_title,omitempty"`
+	CanBeEdited bool             `json:"can_be_edited,omitempty"`
+	IsMember    bool             `json:"is_member,omitempty"`
+	UntilDate   int64            `json:"until_date,omitempty"` // 0 means forever
+	// Administrator rights
+	CanManageChat           bool `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages       bool `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats     bool `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers      bool `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers       bool `json:"can_promote_members,omitempty"`
+	CanPostStories          bool `json:"can_post_stories,omitempty"`
+	CanEditStories          bool `json:"can_edit_stories,omitempty"`
+	CanDeleteStories        bool `json:"can_delete_stories,omitempty"`
+	CanPostMessages         bool `json:"can_post_messages,omitempty"`
+	CanEditMessages         bool `json:"can_edit_messages,omitempty"`
+	CanManageDirectMessages bool `json:"can_manage_direct_messages,omitempty"`
+	// Administrator rights and permissions of restricted members
+	CanChangeInfo   bool `json:"can_change_info,omitempty"`
+	CanInviteUsers  bool `json:"can_invite_users,omitempty"`
+	CanPinMessages  bool `json:"can_pin_messages,omitempty"`
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+	// Permissions of restricted members
+	CanSendMessages       bool `json:"can_send_messages,omitempty"`
+	CanSendAudios         bool `json:"can_send_audios,omitempty"`
+	CanSendDocuments      bool `json:"can_send_documents,omitempty"`
+	CanSendPhotos         bool `json:"can_send_photos,omitempty"`
+	CanSendVideos         bool `json:"can_send_videos,omitempty"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes,omitempty"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes,omitempty"`
+	CanSendPolls          bool `json:"can_send_polls,omitempty"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages,omitempty"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+}
+
+// IsAdmin reports whether the member is the creator or an administrator of the chat.
+func (m *ChatMember) IsAdmin() bool {
+	return m.Status == ChatMemberStatusCreator || m.Status == ChatMemberStatusAdministrator
+}
+
+// IsInChat reports whether the user is currently a member of the chat.
+func (m *ChatMember) IsInChat() bool {
+	switch m.Status {
+	case ChatMemberStatusLeft, ChatMemberStatusKicked:
+		return false
+	case ChatMemberStatusRestricted:
+		return m.IsMember
+	}
+	return true
+}
+
+type ChatRequest struct {
+	ChatID any `json:"chat_id"`
+}
+
+// GetChat gets up-to-date information about the chat.
+// https://core.telegram.org/bots/api#getchat
+func (bot *TelegramBot) GetChat(chatID any) (chat *ChatFullInfo, err error) {
+	err = bot.CallMethod("getChat", &ChatRequest{ChatID: chatID}, &chat)
+	return
+}
+
+// GetChatAdministrators gets the administrators of a chat that aren't bots.
+// https://core.telegram.org/bots/api#getchatadministrators
+func (bot *TelegramBot) GetChatAdministrators(chatID any) (members []*ChatMember, err error) {
+	err = bot.CallMethod("getChatAdministrators", &ChatRequest{ChatID: chatID}, &members)
+	return
+}
+
+// GetChatMemberCount gets the number of members in a chat.
+// https://core.telegram.org/bots/api#getchatmembercount
+func (bot *TelegramBot) GetChatMemberCount(chatID any) (count int, err error) {
+	err = bot.CallMethod("getChatMemberCount", &ChatRequest{ChatID: chatID}, &count)
+	return
+}
+
+type ChatMemberRequest struct {
+	ChatID any   `json:"chat_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// GetChatMember gets information about a member of a chat.
+// The method is only guaranteed to work for other users if the bot is an administrator in the chat.
+// https://core.telegram.org/bots/api#getchatmember
+func (bot *TelegramBot) GetChatMember(chatID any, userID int64) (member *ChatMember, err error) {
+	err = bot.CallMethod("getChatMember", &ChatMemberRequest{ChatID: chatID, UserID: userID}, &member)
+	return
+}
+
+// UntilDate returns the Unix time d from now, as used by the until_date parameters.
+// Telegram treats durations of less than 30 seconds or more than 366 days as forever.
+func UntilDate(d time.Duration) int64 {
+	return time.Now().Add(d).Unix()
+}
+
+type BanChatMemberRequest struct {
+	ChatID         any   `json:"chat_id"`
+	UserID         int64 `json:"user_id"`
+	UntilDate      int64 `json:"until_date,omitempty"`      // Unix time when the user will be unbanned, see UntilDate
+	RevokeMessages bool  `json:"revoke_messages,omitempty"` // Delete all messages of the user from the chat
+}
+
+// BanChatMember bans a user in a group, a supergroup or a channel.
+// The user will not be able to return to the chat on their own using invite links, etc., unless unbanned first.
+// https://core.telegram.org/bots/api#banchatmember
+func (bot *TelegramBot) BanChatMember(req *BanChatMemberRequest) error {
+	return bot.CallMethod("banChatMember", req, nil)
+}
+
+// BanFor bans a user from a chat for the duration d.
+func (bot *TelegramBot) BanFor(chatID any, userID int64, d time.Duration) error {
+	return bot.BanChatMember(&BanChatMemberRequest{ChatID: chatID, UserID: userID, UntilDate: UntilDate(d)})
+}
+
+type UnbanChatMemberRequest struct {
+	ChatID       any   `json:"chat_id"`
+	UserID       int64 `json:"user_id"`
+	OnlyIfBanned bool  `json:"only_if_banned,omitempty"` // Do nothing if the user is not banned
+}
+
+// UnbanChatMember unbans a previously banned user in a supergroup or channel.
+// Without OnlyIfBanned, a user who is a member of the chat is removed from it.
+// https://core.telegram.org/bots/api#unbanchatmember
+func (bot *TelegramBot) UnbanChatMember(req *UnbanChatMemberRequest) error {
+	return bot.CallMethod("unbanChatMember", req, nil)
+}
+
+// AllChatPermissions returns permissions allowing everything, which lifts the
+// restrictions of a member when passed to RestrictChatMember.
+func AllChatPermissions() *ChatPermissions {
+	return &ChatPermissions{
+		CanSendMessages:       true,
+		CanSendAudios:         true,
+		CanSendDocuments:      true,
+		CanSendPhotos:         true,
+		CanSendVideos:         true,
+		CanSendVideoNotes:     true,
+		CanSendVoiceNotes:     true,
+		CanSendPolls:          true,
+		CanSendOtherMessages:  true,
+		CanAddWebPagePreviews: true,
+		CanChangeInfo:         true,
+		CanInviteUsers:        true,
+		CanPinMessages:        true,
+		CanManageTopics:       true,
+	}
+}
+
+// SetMessages sets whether text messages, contacts, invoices, locations and venues can be sent.
+func (p *ChatPermissions) SetMessages(allowed bool) *ChatPermissions {
+	p.CanSendMessages = allowed
+	return p
+}
+
+// SetMedia sets whether audios, documents, photos, videos, video notes and voice notes can be sent.
+func (p *ChatPermissions) SetMedia(allowed bool) *ChatPermissions {
+	p.CanSendAudios = allowed
+	p.CanSendDocuments = allowed
+	p.CanSendPhotos = allowed
+	p.CanSendVideos = allowed
+	p.CanSendVideoNotes = allowed
+	p.CanSendVoiceNotes = allowed
+	return p
+}
+
+// SetPolls sets whether polls and checklists can be sent.
+func (p *ChatPermissions) SetPolls(allowed bool) *ChatPermissions {
+	p.CanSendPolls = allowed
+	return p
+}
+
+// SetOther sets whether animations, games, stickers, inline bot results and link previews can be sent.
+func (p *ChatPermissions) SetOther(allowed bool) *ChatPermissions {
+	p.CanSendOtherMessages = allowed
+	p.CanAddWebPagePreviews = allowed
+	return p
+}
+
+type RestrictChatMemberRequest struct {
+	ChatID      any              `json:"chat_id"`
+	UserID      int64            `json:"user_id"`
+	Permissions *ChatPermissions `json:"permissions"`
+	// Apply the permissions as is. Otherwise can_send_other_messages and can_add_web_page_previews
+	// imply the media permissions, which imply can_send_messages.
+	UseIndependentChatPermissions bool  `json:"use_independent_chat_permissions,omitempty"`
+	UntilDate                     int64 `json:"until_date,omitempty"` // Unix time when the restrictions are lifted, see UntilDate
+}
+
+// RestrictChatMember restricts a user in a supergroup.
+// Pass AllChatPermissions to lift the restrictions.
+// https://core.telegram.org/bots/api#restrictchatmember
+func (bot *TelegramBot) RestrictChatMember(req *RestrictChatMemberRequest) error {
+	return bot.CallMethod("restrictChatMember", req, nil)
+}
+
+// MuteUser prevents a user from sending anything to a supergroup for the duration d, or forever if d is 0.
+func (bot *TelegramBot) MuteUser(chatID any, userID int64, d time.Duration) error {
+	req := &RestrictChatMemberRequest{
+		ChatID:                        chatID,
+		UserID:                        userID,
+		Permissions:                   &ChatPermissions{},
+		UseIndependentChatPermissions: true,
+	}
+	if d > 0 {
+		req.UntilDate = UntilDate(d)
+	}
+	return bot.RestrictChatMember(req)
+}
+
+// UnmuteUser lifts the restrictions of a user muted with MuteUser.
+func (bot *TelegramBot) UnmuteUser(chatID any, userID int64) error {
+	return bot.RestrictChatMember(&RestrictChatMemberRequest{
+		ChatID:      chatID,
+		UserID:      userID,
+		Permissions: AllChatPermissions(),
+	})
+}
+
+type PromoteChatMemberRequest struct {
+	ChatID any   `json:"chat_id"`
+	UserID int64 `json:"user_id"`
+	// Rights granted to the user, passing none of them demotes the user
+	ChatAdministratorRights
+}
+
+// PromoteChatMember promotes or demotes a user in a supergroup or a channel.
+// The bot must be an administrator in the chat with the appropriate rights.
+// https://core.telegram.org/bots/api#promotechatmember
+func (bot *TelegramBot) PromoteChatMember(req *PromoteChatMemberRequest) error {
+	return bot.CallMethod("promoteChatMember", req, nil)
+}
+
+type ChatAdministratorCustomTitleRequest struct {
+	ChatID      any    `json:"chat_id"`
+	UserID      int64  `json:"user_id"`
+	CustomTitle string `json:"custom_title"` // 0-16 characters, emoji are not allowed
+}
+
+// SetChatAdministratorCustomTitle sets a custom title for an administrator in a supergroup promoted by the bot.
+// https://core.telegram.org/bots/api#setchatadministratorcustomtitle
+func (bot *TelegramBot) SetChatAdministratorCustomTitle(chatID any, userID int64, title string) error {
+	return bot.CallMethod("setChatAdministratorCustomTitle", &ChatAdministratorCustomTitleRequest{
+		ChatID:      chatID,
+		UserID:      userID,
+		CustomTitle: title,
+	}, nil)
+}
+
+type ChatSenderChatRequest struct {
+	ChatID       any   `json:"chat_id"`
+	SenderChatID int64 `json:"sender_chat_id"`
+}
+
+// BanChatSenderChat bans a channel chat in a supergroup or a channel.
+// The owner of the banned chat won't be able to send messages on behalf of any of their channels until unbanned.
+// https://core.telegram.org/bots/api#banchatsenderchat
+func (bot *TelegramBot) BanChatSenderChat(chatID any, senderChatID int64) error {
+	return bot.CallMethod("banChatSenderChat", &ChatSenderChatRequest{ChatID: chatID, SenderChatID: senderChatID}, nil)
+}
+
+// UnbanChatSenderChat unbans a previously banned channel chat in a supergroup or channel.
+// https://core.telegram.org/bots/api#unbanchatsenderchat
+func (bot *TelegramBot) UnbanChatSenderChat(chatID any, senderChatID int64) error {
+	return bot.CallMethod("unbanChatSenderChat", &ChatSenderChatRequest{ChatID: chatID, SenderChatID: senderChatID}, nil)
+}
+
+type ChatPermissionsRequest struct {
+	ChatID      any              `json:"chat_id"`
+	Permissions *ChatPermissions `json:"permissions"`
+	// Apply the permissions as is, see RestrictChatMemberRequest
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+}
+
+// SetChatPermissions sets the default permissions of all members of a group or a supergroup.
+// The bot must be an administrator in the chat with the can_restrict_members right.
+// https://core.telegram.org/bots/api#setchatpermissions
+func (bot *TelegramBot) SetChatPermissions(req *ChatPermissionsRequest) error {
+	return bot.CallMethod("setChatPermissions", req, nil)
+}
+
+type ChatPhotoRequest struct {
+	ChatID any        `json:"chat_id"`
+	Photo  *InputFile `json:"photo"` // Must be a new upload
+}
+
+// SetChatPhoto sets a new profile photo for the chat. Photos can't be changed for private chats.
+// https://core.telegram.org/bots/api#setchatphoto
+func (bot *TelegramBot) SetChatPhoto(chatID any, photo *InputFile) error {
+	return bot.CallMethod("setChatPhoto", &ChatPhotoRequest{ChatID: chatID, Photo: photo}, nil)
+}
+
+// DeleteChatPhoto deletes the chat photo. Photos can't be changed for private chats.
+// https://core.telegram.org/bots/api#deletechatphoto
+func (bot *TelegramBot) DeleteChatPhoto(chatID any) error {
+	return bot.CallMethod("deleteChatPhoto", &ChatRequest{ChatID: chatID}, nil)
+}
+
+type ChatTitleRequest struct {
+	ChatID any    `json:"chat_id"`
+	Title  string `json:"title"` // 1-128 characters
+}
+
+// SetChatTitle changes the title of a chat. Titles can't be changed for private chats.
+// https://core.telegram.org/bots/api#setchattitle
+func (bot *TelegramBot) SetChatTitle(chatID any, title string) error {
+	return bot.CallMethod("setChatTitle", &ChatTitleRequest{ChatID: chatID, Title: title}, nil)
+}
+
+type ChatDescriptionRequest struct {
+	ChatID      any    `json:"chat_id"`
+	Description string `json:"description,omitempty"` // 0-255 characters
+}
+
+// SetChatDescription changes the description of a group, a supergroup or a channel.
+// https://core.telegram.org/bots/api#setchatdescription
+func (bot *TelegramBot) SetChatDescription(chatID any, description string) error {
+	return bot.CallMethod("setChatDescription", &ChatDescriptionRequest{ChatID: chatID, Description: description}, nil)
+}
+
+type ChatStickerSetRequest struct {
+	ChatID         any    `json:"chat_id"`
+	StickerSetName string `json:"sticker_set_name,omitempty"`
+}
+
+// SetChatStickerSet sets a new group sticker set for a supergroup.
+// Use ChatFullInfo.CanSetStickerSet to check if the bot can use this method, which
+// depends on the boost level of the chat; otherwise ErrCantSetStickerSet is returned.
+// https://core.telegram.org/bots/api#setchatstickerset
+func (bot *TelegramBot) SetChatStickerSet(chatID any, stickerSetName string) error {
+	return bot.CallMethod("setChatStickerSet", &ChatStickerSetRequest{ChatID: chatID, StickerSetName: stickerSetName}, nil)
+}
+
+// DeleteChatStickerSet deletes the group sticker set from a supergroup, see SetChatStickerSet.
+// https://core.telegram.org/bots/api#deletechatstickerset
+func (bot *TelegramBot) DeleteChatStickerSet(chatID any) error {
+	return bot.CallMethod("deleteChatStickerSet", &ChatRequest{ChatID: chatID}, nil)
+}
+
+// ChatMemberUpdated represents changes in the status of a chat member.
+// @docs https://core.telegram.org/bots/api#chatmemberupdated
+type ChatMemberUpdated struct {
+	Chat                    *Chat           `json:"chat"`
+	From                    *User           `json:"from"` // Performer of the action, which resulted in the change
+	Date                    int64           `json:"date"`
+	OldChatMember           *ChatMember     `json:"old_chat_member"`
+	NewChatMember           *ChatMember     `json:"new_chat_member"`
+	InviteLink              *ChatInviteLink `json:"invite_link,omitempty"`
+	ViaJoinRequest          bool            `json:"via_join_request,omitempty"`
+	ViaChatFolderInviteLink bool            `json:"via_chat_folder_invite_link,omitempty"`
+}
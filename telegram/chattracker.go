@@ -0,0 +1,133 @@
+package telegram
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// chatTrackerKey is the Store key of the chats known to a ChatTracker.
+const chatTrackerKey = "chats"
+
+// ChatMembership is the status of the bot in a chat.
+type ChatMembership struct {
+	Chat   *Chat       `json:"chat"`
+	Member *ChatMember `json:"member"` // The bot, with its status and rights in the chat
+	Date   int64       `json:"date"`   // Date of the last change
+}
+
+// ChatTracker maintains the list of chats the bot belongs to from
+// my_chat_member updates, persisted in a Store:
+//
+//	tracker, err := bot.TrackChats(store)
+//	router.OnMyChatMember(tracker.Handle)
+//	...
+//	for _, m := range bot.Chats() { ... }
+type ChatTracker struct {
+	mu       sync.RWMutex
+	store    Store
+	chats    map[int64]*ChatMembership
+	onChange []func(update *ChatMemberUpdated)
+}
+
+// TrackChats creates a ChatTracker loading the chats known from store,
+// used by bot.Chats.
+func (bot *TelegramBot) TrackChats(store Store) (tracker *ChatTracker, err error) {
+	tracker = &ChatTracker{store: store, chats: make(map[int64]*ChatMembership)}
+	data, ok, err := store.Get(chatTrackerKey)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if err = json.Unmarshal(data, &tracker.chats); err != nil {
+			return nil, err
+		}
+	}
+	bot.mu.Lock()
+	bot.chats = tracker
+	bot.mu.Unlock()
+	return
+}
+
+// Chats returns the chats the bot is a member of, if tracked with TrackChats.
+func (bot *TelegramBot) Chats() []*ChatMembership {
+	bot.mu.Lock()
+	tracker := bot.chats
+	bot.mu.Unlock()
+	if tracker == nil {
+		return nil
+	}
+	return tracker.Chats()
+}
+
+// OnChange registers a function called after every change of the bot's status in a chat.
+func (t *ChatTracker) OnChange(fn func(update *ChatMemberUpdated)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onChange = append(t.onChange, fn)
+}
+
+// Handle records a my_chat_member update.
+// Chats the bot left, was removed from or blocked by are forgotten.
+func (t *ChatTracker) Handle(update *ChatMemberUpdated) error {
+	t.mu.Lock()
+	if update.NewChatMember.IsInChat() {
+		t.chats[update.Chat.ID] = &ChatMembership{
+			Chat:   update.Chat,
+			Member: update.NewChatMember,
+			Date:   update.Date,
+		}
+	} else {
+		delete(t.chats, update.Chat.ID)
+	}
+	data, err := json.Marshal(t.chats)
+	if err == nil {
+		err = t.store.Set(chatTrackerKey, data)
+	}
+	onChange := t.onChange
+	t.mu.Unlock()
+	for _, fn := range onChange {
+		fn(update)
+	}
+	return err
+}
+
+// Chat returns the status of the bot in a chat, ok is false if it's not a member.
+func (t *ChatTracker) Chat(chatID int64) (membership *ChatMembership, ok bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	membership, ok = t.chats[chatID]
+	return
+}
+
+// Chats returns the chats the bot is a member of, ordered by ID.
+func (t *ChatTracker) Chats() (chats []*ChatMembership) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, m := range t.chats {
+		chats = append(chats, m)
+	}
+	sort.Slice(chats, func(i, j int) bool {
+		return chats[i].Chat.ID < chats[j].Chat.ID
+	})
+	return
+}
+
+// ChatsOfType returns the chats of the given type the bot is a member of.
+func (t *ChatTracker) ChatsOfType(chatType ChatType) (chats []*ChatMembership) {
+	for _, m := range t.Chats() {
+		if m.Chat.Type == chatType {
+			chats = append(chats, m)
+		}
+	}
+	return
+}
+
+// ChatIDs returns the identifiers of the chats the bot is a member of, ordered by ID,
+// for instance to Run a Broadcast.
+func (t *ChatTracker) ChatIDs() (ids []int64) {
+	for _, m := range t.Chats() {
+		ids = append(ids, m.Chat.ID)
+	}
+	return
+}
@@ -0,0 +1,144 @@
+package telegram
+
+// ChecklistTask describes a task in a checklist.
+// @docs https://core.telegram.org/bots/api#checklisttask
+type ChecklistTask struct {
+	ID              int              `json:"id"`
+	Text            string           `json:"text"`
+	TextEntities    []*MessageEntity `json:"text_entities,omitempty"`
+	CompletedByUser *User            `json:"completed_by_user,omitempty"`
+	CompletedByChat *Chat            `json:"completed_by_chat,omitempty"`
+	CompletionDate  int64            `json:"completion_date,omitempty"` // 0 if the task isn't completed
+}
+
+// IsDone reports whether the task has been completed.
+func (t *ChecklistTask) IsDone() bool {
+	return t.CompletionDate != 0
+}
+
+// Checklist describes a checklist.
+// @docs https://core.telegram.org/bots/api#checklist
+type Checklist struct {
+	Title                    string           `json:"title"`
+	TitleEntities            []*MessageEntity `json:"title_entities,omitempty"`
+	Tasks                    []*ChecklistTask `json:"tasks"`
+	OthersCanAddTasks        bool             `json:"others_can_add_tasks,omitempty"`
+	OthersCanMarkTasksAsDone bool             `json:"others_can_mark_tasks_as_done,omitempty"`
+}
+
+// Task returns the task with the given identifier, or nil.
+func (c *Checklist) Task(id int) *ChecklistTask {
+	for _, task := range c.Tasks {
+		if task.ID == id {
+			return task
+		}
+	}
+	return nil
+}
+
+// ChecklistTasksDone describes a service message about checklist tasks marked as done or not done.
+// @docs https://core.telegram.org/bots/api#checklisttasksdone
+type ChecklistTasksDone struct {
+	ChecklistMessage       *Message `json:"checklist_message,omitempty"`
+	MarkedAsDoneTaskIDs    []int    `json:"marked_as_done_task_ids,omitempty"`
+	MarkedAsNotDoneTaskIDs []int    `json:"marked_as_not_done_task_ids,omitempty"`
+}
+
+// ChecklistTasksAdded describes a service message about tasks added to a checklist.
+// @docs https://core.telegram.org/bots/api#checklisttasksadded
+type ChecklistTasksAdded struct {
+	ChecklistMessage *Message         `json:"checklist_message,omitempty"`
+	Tasks            []*ChecklistTask `json:"tasks"`
+}
+
+// InputChecklistTask describes a task to add to a checklist.
+// @docs https://core.telegram.org/bots/api#inputchecklisttask
+type InputChecklistTask struct {
+	ID           int              `json:"id"` // Positive and unique among all the tasks of the checklist
+	Text         string           `json:"text"`
+	ParseMode    ParseMode        `json:"parse_mode,omitempty"`
+	TextEntities []*MessageEntity `json:"text_entities,omitempty"`
+}
+
+// InputChecklist describes a checklist to create.
+// @docs https://core.telegram.org/bots/api#inputchecklist
+type InputChecklist struct {
+	Title                    string                `json:"title"`
+	ParseMode                ParseMode             `json:"parse_mode,omitempty"`
+	TitleEntities            []*MessageEntity      `json:"title_entities,omitempty"`
+	Tasks                    []*InputChecklistTask `json:"tasks"` // 1-30 tasks
+	OthersCanAddTasks        bool                  `json:"others_can_add_tasks,omitempty"`
+	OthersCanMarkTasksAsDone bool                  `json:"others_can_mark_tasks_as_done,omitempty"`
+}
+
+// NewChecklist returns a checklist of plain text tasks, numbered from 1.
+func NewChecklist(title string, tasks ...string) *InputChecklist {
+	c := &InputChecklist{Title: title}
+	for _, text := range tasks {
+		c.AddTask(text)
+	}
+	return c
+}
+
+// AddTask appends a plain text task, with the next free identifier, and returns it.
+func (c *InputChecklist) AddTask(text string) *InputChecklistTask {
+	id := 0
+	for _, task := range c.Tasks {
+		id = max(id, task.ID)
+	}
+	task := &InputChecklistTask{ID: id + 1, Text: text}
+	c.Tasks = append(c.Tasks, task)
+	return task
+}
+
+// Input returns the checklist as an InputChecklist, to edit it with EditMessageChecklist.
+// Task identifiers are kept, so that the completion of the tasks is preserved.
+func (c *Checklist) Input() *InputChecklist {
+	input := &InputChecklist{
+		Title:                    c.Title,
+		TitleEntities:            c.TitleEntities,
+		OthersCanAddTasks:        c.OthersCanAddTasks,
+		OthersCanMarkTasksAsDone: c.OthersCanMarkTasksAsDone,
+	}
+	for _, task := range c.Tasks {
+		input.Tasks = append(input.Tasks, &InputChecklistTask{
+			ID:           task.ID,
+			Text:         task.Text,
+			TextEntities: task.TextEntities,
+		})
+	}
+	return input
+}
+
+type SendChecklistRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id"`
+	ChatID               int64                 `json:"chat_id"`
+	Checklist            *InputChecklist       `json:"checklist"`
+	DisableNotification  bool                  `json:"disable_notification,omitempty"`
+	ProtectContent       bool                  `json:"protect_content,omitempty"`
+	MessageEffectID      string                `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters      `json:"reply_parameters,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendChecklist sends a checklist on behalf of a connected business account.
+// https://core.telegram.org/bots/api#sendchecklist
+func (bot *TelegramBot) SendChecklist(req *SendChecklistRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendChecklist", req, &result)
+	return
+}
+
+type EditMessageChecklistRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id"`
+	ChatID               int64                 `json:"chat_id"`
+	MessageID            int64                 `json:"message_id"`
+	Checklist            *InputChecklist       `json:"checklist"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageChecklist edits a checklist sent on behalf of a connected business account.
+// https://core.telegram.org/bots/api#editmessagechecklist
+func (bot *TelegramBot) EditMessageChecklist(req *EditMessageChecklistRequest) (result *Message, err error) {
+	err = bot.CallMethod("editMessageChecklist", req, &result)
+	return
+}
@@ -0,0 +1,145 @@
+package telegram
+
+import (
+	"context"
+	"time"
+)
+
+// CheckoutDeadline is the time the bot has to answer shipping and pre-checkout queries.
+const CheckoutDeadline = 10 * time.Second
+
+// ShippingQuery contains information about an incoming shipping query,
+// received for invoices with IsFlexible set.
+// @docs https://core.telegram.org/bots/api#shippingquery
+type ShippingQuery struct {
+	ID              string           `json:"id"`
+	From            *User            `json:"from"`
+	InvoicePayload  string           `json:"invoice_payload"`
+	ShippingAddress *ShippingAddress `json:"shipping_address"`
+}
+
+// PreCheckoutQuery contains information about an incoming pre-checkout query,
+// sent once the user confirmed the payment and shipping details.
+// @docs https://core.telegram.org/bots/api#precheckoutquery
+type PreCheckoutQuery struct {
+	ID               string     `json:"id"`
+	From             *User      `json:"from"`
+	Currency         string     `json:"currency"`
+	TotalAmount      int        `json:"total_amount"` // In the smallest units of the currency
+	InvoicePayload   string     `json:"invoice_payload"`
+	ShippingOptionID string     `json:"shipping_option_id,omitempty"`
+	OrderInfo        *OrderInfo `json:"order_info,omitempty"`
+}
+
+// ShippingOption represents one shipping option.
+// @docs https://core.telegram.org/bots/api#shippingoption
+type ShippingOption struct {
+	ID     string          `json:"id"`
+	Title  string          `json:"title"`
+	Prices []*LabeledPrice `json:"prices"`
+}
+
+type AnswerShippingQueryRequest struct {
+	ShippingQueryID string            `json:"shipping_query_id"`
+	OK              bool              `json:"ok"`                         // Whether delivery to the address is possible
+	ShippingOptions []*ShippingOption `json:"shipping_options,omitempty"` // Required if OK is true
+	ErrorMessage    string            `json:"error_message,omitempty"`    // Required if OK is false
+}
+
+// AnswerShippingQuery replies to a shipping query.
+// https://core.telegram.org/bots/api#answershippingquery
+func (bot *TelegramBot) AnswerShippingQuery(req *AnswerShippingQueryRequest) error {
+	return bot.CallMethod("answerShippingQuery", req, nil)
+}
+
+type AnswerPreCheckoutQueryRequest struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	OK                 bool   `json:"ok"`                      // Whether the goods are available and the bot is ready to proceed with the order
+	ErrorMessage       string `json:"error_message,omitempty"` // Required if OK is false
+}
+
+// AnswerPreCheckoutQuery confirms or rejects an order.
+// The bot must answer within CheckoutDeadline of receiving the query.
+// https://core.telegram.org/bots/api#answerprecheckoutquery
+func (bot *TelegramBot) AnswerPreCheckoutQuery(req *AnswerPreCheckoutQueryRequest) error {
+	return bot.CallMethod("answerPreCheckoutQuery", req, nil)
+}
+
+// checkoutTimeout leaves a margin before CheckoutDeadline to send the answer.
+const checkoutTimeout = CheckoutDeadline - 2*time.Second
+
+// CheckoutTimeoutMessage is the error message shown to users when the handler of a
+// checkout query doesn't return in time.
+var CheckoutTimeoutMessage = "Sorry, we couldn't process your order in time. Please try again."
+
+// CheckoutErrorMessage is the error message shown to users when the handler of a
+// checkout query returns an error without text.
+var CheckoutErrorMessage = "Sorry, we couldn't process your order. Please try again."
+
+// answerInTime runs fn with a context expiring before CheckoutDeadline and
+// calls answer with ok and the text of its error, or with CheckoutTimeoutMessage
+// if it doesn't return in time.
+func answerInTime(fn func(ctx context.Context) error, answer func(ok bool, errorMessage string) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), checkoutTimeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx)
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			return answer(true, "")
+		}
+		if message := err.Error(); message != "" {
+			return answer(false, message)
+		}
+		return answer(false, CheckoutErrorMessage)
+	case <-ctx.Done():
+		return answer(false, CheckoutTimeoutMessage)
+	}
+}
+
+// HandleShippingQuery returns a handler for Router.OnShippingQuery answering
+// queries with the options returned by fn, or rejecting the address with the
+// text of its error. fn is given a context expiring before CheckoutDeadline.
+func (bot *TelegramBot) HandleShippingQuery(fn func(ctx context.Context, query *ShippingQuery) ([]*ShippingOption, error)) func(query *ShippingQuery) error {
+	return func(query *ShippingQuery) error {
+		var options []*ShippingOption
+		return answerInTime(func(ctx context.Context) (err error) {
+			options, err = fn(ctx, query)
+			return
+		}, func(ok bool, errorMessage string) error {
+			req := &AnswerShippingQueryRequest{ShippingQueryID: query.ID, OK: ok, ErrorMessage: errorMessage}
+			if ok {
+				req.ShippingOptions = options
+			}
+			return bot.AnswerShippingQuery(req)
+		})
+	}
+}
+
+// HandlePreCheckoutQuery returns a handler for Router.OnPreCheckoutQuery
+// confirming orders for which fn returns nil, and rejecting the others with
+// the text of the error, shown to the user. fn is given a context expiring
+// before CheckoutDeadline.
+//
+//	router.OnPreCheckoutQuery(bot.HandlePreCheckoutQuery(func(ctx context.Context, q *telegram.PreCheckoutQuery) error {
+//		if !inStock(ctx, q.InvoicePayload) {
+//			return errors.New("Sorry, this item is sold out")
+//		}
+//		return nil
+//	}))
+func (bot *TelegramBot) HandlePreCheckoutQuery(fn func(ctx context.Context, query *PreCheckoutQuery) error) func(query *PreCheckoutQuery) error {
+	return func(query *PreCheckoutQuery) error {
+		return answerInTime(func(ctx context.Context) error {
+			return fn(ctx, query)
+		}, func(ok bool, errorMessage string) error {
+			return bot.AnswerPreCheckoutQuery(&AnswerPreCheckoutQueryRequest{
+				PreCheckoutQueryID: query.ID,
+				OK:                 ok,
+				ErrorMessage:       errorMessage,
+			})
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package telegram
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// DefaultConfirmTimeout is how long Confirm waits for an answer when ctx has no deadline.
+const DefaultConfirmTimeout = time.Minute
+
+// confirmPrefix is the callback prefix of the buttons sent by Confirm.
+const confirmPrefix = "confirm"
+
+// ConfirmExpiredMessage is the notification shown to users pressing a button
+// of a question Confirm doesn't wait for anymore.
+var ConfirmExpiredMessage = "This confirmation has expired."
+
+// Confirm asks the sender of message a Yes/No question in its chat, replying
+// with an inline keyboard sent by r.Bot, and waits for their answer. Presses
+// of other users are ignored. The keyboard is removed once answered or when
+// ctx is done, in which case Confirm returns false with the error of ctx.
+// Messages sent on behalf of a chat, such as channel posts, can't be confirmed.
+//
+// The router must keep handling updates while Confirm waits, so call it from
+// a goroutine rather than directly from a handler:
+//
+//	router.Bot = bot
+//	router.OnCommand("reset", func(msg *telegram.Message, args string) error {
+//		go func() {
+//			if ok, _ := router.Confirm(ctx, msg, "Delete everything?"); ok {
+//				...
+//			}
+//		}()
+//		return nil
+//	})
+func (r *Router) Confirm(ctx context.Context, message *Message, text string) (ok bool, err error) {
+	bot := r.Bot
+	if bot == nil {
+		return false, fmt.Errorf("telegram: router has no bot to confirm with")
+	}
+	if message.From == nil {
+		return false, fmt.Errorf("telegram: can't confirm a message without sender user")
+	}
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultConfirmTimeout)
+		defer cancel()
+	}
+	id, queries, err := r.addConfirm()
+	if err != nil {
+		return
+	}
+	defer r.removeConfirm(id)
+	button := func(text, answer string) *InlineKeyboardButton {
+		return CallbackButton(text, confirmPrefix+callbackSeparator+id+callbackSeparator+answer)
+	}
+	sent, err := bot.SendMessage(&MessageRequest{
+		ChatID:          message.Chat.ID,
+		MessageThreadID: message.MessageThreadID,
+		Text:            text,
+		ReplyParameters: &ReplyParameters{MessageID: message.MessageID},
+		ReplyMarkup:     NewInlineKeyboard().Row(button("Yes", "y"), button("No", "n")),
+	})
+	if err != nil {
+		return
+	}
+	for answered := false; !answered && err == nil; {
+		select {
+		case query := <-queries:
+			if query.From.ID != message.From.ID {
+				err = bot.AnswerCallback(query, "")
+				continue
+			}
+			_, payload := splitCallback(query.Data)
+			_, answer := splitCallback(payload)
+			ok, answered = answer == "y", true
+			err = bot.AnswerCallback(query, "")
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	_, editErr := bot.EditMessageReplyMarkup(&EditMessageReplyMarkupRequest{ChatID: sent.Chat.ID, MessageID: sent.MessageID})
+	if err == nil {
+		err = editErr
+	}
+	return
+}
+
+// addConfirm registers a pending Confirm, whose callback queries are
+// delivered to queries by answerConfirm.
+func (r *Router) addConfirm() (id string, queries chan *CallbackQuery, err error) {
+	nonce := make([]byte, 8)
+	if _, err = rand.Read(nonce); err != nil {
+		return
+	}
+	id = hex.EncodeToString(nonce)
+	queries = make(chan *CallbackQuery, 8)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.confirms == nil {
+		r.confirms = make(map[string]chan *CallbackQuery)
+	}
+	r.confirms[id] = queries
+	return
+}
+
+func (r *Router) removeConfirm(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.confirms, id)
+}
+
+// answerConfirm passes the callback queries of pending Confirm calls to them,
+// before any handler, and reports whether update was one. Presses of expired
+// questions, and those exceeding the queue of a pending one, are answered here.
+func (r *Router) answerConfirm(update *Update) (handled bool, err error) {
+	query := update.CallbackQuery
+	if query == nil {
+		return false, nil
+	}
+	prefix, payload := splitCallback(query.Data)
+	if prefix != confirmPrefix {
+		return false, nil
+	}
+	id, _ := splitCallback(payload)
+	r.mu.Lock()
+	queries, ok := r.confirms[id]
+	r.mu.Unlock()
+	text := ConfirmExpiredMessage
+	if ok {
+		select {
+		case queries <- query:
+			return true, nil
+		default:
+			text = ""
+		}
+	}
+	if r.Bot != nil {
+		err = r.Bot.AnswerCallback(query, text)
+	}
+	return true, err
+}
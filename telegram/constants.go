@@ -0,0 +1,129 @@
+package telegram
+
+// ParseMode is the formatting syntax of message text and captions.
+// @docs https://core.telegram.org/bots/api#formatting-options
+type ParseMode string
+
+const (
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
+	ParseModeHTML       ParseMode = "HTML"
+	// ParseModeMarkdown is a legacy mode, kept for backward compatibility.
+	ParseModeMarkdown ParseMode = "Markdown"
+)
+
+// ChatType is the type of a chat.
+type ChatType string
+
+const (
+	ChatTypePrivate    ChatType = "private"
+	ChatTypeGroup      ChatType = "group"
+	ChatTypeSupergroup ChatType = "supergroup"
+	ChatTypeChannel    ChatType = "channel"
+	// ChatTypeSender is only used by inline queries, for a private chat with the inline query sender.
+	ChatTypeSender ChatType = "sender"
+)
+
+// ChatMemberStatus is the status of a ChatMember.
+type ChatMemberStatus string
+
+const (
+	ChatMemberStatusCreator       ChatMemberStatus = "creator"
+	ChatMemberStatusAdministrator ChatMemberStatus = "administrator"
+	ChatMemberStatusMember        ChatMemberStatus = "member"
+	ChatMemberStatusRestricted    ChatMemberStatus = "restricted"
+	ChatMemberStatusLeft          ChatMemberStatus = "left"
+	ChatMemberStatusKicked        ChatMemberStatus = "kicked"
+)
+
+// EntityType is the type of a MessageEntity.
+// @docs https://core.telegram.org/bots/api#messageentity
+type EntityType string
+
+const (
+	EntityTypeMention              EntityType = "mention"
+	EntityTypeHashtag              EntityType = "hashtag"
+	EntityTypeCashtag              EntityType = "cashtag"
+	EntityTypeBotCommand           EntityType = "bot_command"
+	EntityTypeURL                  EntityType = "url"
+	EntityTypeEmail                EntityType = "email"
+	EntityTypePhoneNumber          EntityType = "phone_number"
+	EntityTypeBold                 EntityType = "bold"
+	EntityTypeItalic               EntityType = "italic"
+	EntityTypeUnderline            EntityType = "underline"
+	EntityTypeStrikethrough        EntityType = "strikethrough"
+	EntityTypeSpoiler              EntityType = "spoiler"
+	EntityTypeBlockquote           EntityType = "blockquote"
+	EntityTypeExpandableBlockquote EntityType = "expandable_blockquote"
+	EntityTypeCode                 EntityType = "code"
+	EntityTypePre                  EntityType = "pre"
+	EntityTypeTextLink             EntityType = "text_link"
+	EntityTypeTextMention          EntityType = "text_mention"
+	EntityTypeCustomEmoji          EntityType = "custom_emoji"
+)
+
+// ChatActionType is the kind of action broadcast by SendChatAction.
+// @docs https://core.telegram.org/bots/api#sendchataction
+type ChatActionType string
+
+const (
+	ChatActionTyping          ChatActionType = "typing"
+	ChatActionUploadPhoto     ChatActionType = "upload_photo"
+	ChatActionRecordVideo     ChatActionType = "record_video"
+	ChatActionUploadVideo     ChatActionType = "upload_video"
+	ChatActionRecordVoice     ChatActionType = "record_voice"
+	ChatActionUploadVoice     ChatActionType = "upload_voice"
+	ChatActionUploadDocument  ChatActionType = "upload_document"
+	ChatActionChooseSticker   ChatActionType = "choose_sticker"
+	ChatActionFindLocation    ChatActionType = "find_location"
+	ChatActionRecordVideoNote ChatActionType = "record_video_note"
+	ChatActionUploadVideoNote ChatActionType = "upload_video_note"
+)
+
+// DiceEmoji is the emoji on which a dice throw animation is based.
+// @docs https://core.telegram.org/bots/api#dice
+type DiceEmoji string
+
+const (
+	DiceEmojiDice        DiceEmoji = "🎲" // Values 1-6
+	DiceEmojiDarts       DiceEmoji = "🎯" // Values 1-6
+	DiceEmojiBowling     DiceEmoji = "🎳" // Values 1-6
+	DiceEmojiBasketball  DiceEmoji = "🏀" // Values 1-5
+	DiceEmojiFootball    DiceEmoji = "⚽" // Values 1-5
+	DiceEmojiSlotMachine DiceEmoji = "🎰" // Values 1-64
+)
+
+// PollType is the type of a poll.
+type PollType string
+
+const (
+	PollTypeRegular PollType = "regular"
+	PollTypeQuiz    PollType = "quiz"
+)
+
+// Update types, as used in the allowed_updates parameter of GetUpdates.
+// @docs https://core.telegram.org/bots/api#update
+const (
+	UpdateTypeMessage                 = "message"
+	UpdateTypeEditedMessage           = "edited_message"
+	UpdateTypeChannelPost             = "channel_post"
+	UpdateTypeEditedChannelPost       = "edited_channel_post"
+	UpdateTypeBusinessConnection      = "business_connection"
+	UpdateTypeBusinessMessage         = "business_message"
+	UpdateTypeEditedBusinessMessage   = "edited_business_message"
+	UpdateTypeDeletedBusinessMessages = "deleted_business_messages"
+	UpdateTypeMessageReaction         = "message_reaction"
+	UpdateTypeMessageReactionCount    = "message_reaction_count"
+	UpdateTypeInlineQuery             = "inline_query"
+	UpdateTypeChosenInlineResult      = "chosen_inline_result"
+	UpdateTypeCallbackQuery           = "callback_query"
+	UpdateTypeShippingQuery           = "shipping_query"
+	UpdateTypePreCheckoutQuery        = "pre_checkout_query"
+	UpdateTypePurchasedPaidMedia      = "purchased_paid_media"
+	UpdateTypePoll                    = "poll"
+	UpdateTypePollAnswer              = "poll_answer"
+	UpdateTypeMyChatMember            = "my_chat_member"
+	UpdateTypeChatMember              = "chat_member"
+	UpdateTypeChatJoinRequest         = "chat_join_request"
+	UpdateTypeChatBoost               = "chat_boost"
+	UpdateTypeRemovedChatBoost        = "removed_chat_boost"
+)
@@ -0,0 +1,199 @@
+package telegram
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Maximum lengths of deep link start parameters, for bots and Mini Apps.
+// Only the characters A-Z, a-z, 0-9, _ and - are allowed, see EncodeStartPayload.
+const (
+	MaxStartPayloadLength    = 64
+	MaxStartAppPayloadLength = 512
+)
+
+// validateStartPayload checks that payload can be passed by a deep link.
+func validateStartPayload(payload string, limit int) error {
+	if len(payload) > limit {
+		return fmt.Errorf("telegram: start payload %q exceeds %d characters", payload, limit)
+	}
+	for _, c := range payload {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return fmt.Errorf("telegram: start payload %q contains %q", payload, c)
+		}
+	}
+	return nil
+}
+
+// StartLink returns a link opening a private chat with the bot, which
+// receives "/start payload" when the user presses Start.
+// It fails if payload isn't a valid start parameter.
+// @docs https://core.telegram.org/api/links#bot-links
+func StartLink(botUsername, payload string) (string, error) {
+	if err := validateStartPayload(payload, MaxStartPayloadLength); err != nil {
+		return "", err
+	}
+	return "https://t.me/" + botUsername + "?start=" + payload, nil
+}
+
+// StartGroupLink returns a link prompting the user to add the bot to a group,
+// requesting the administrator rights set in rights, if not nil.
+// It fails if payload isn't a valid start parameter.
+func StartGroupLink(botUsername, payload string, rights *ChatAdministratorRights) (string, error) {
+	if err := validateStartPayload(payload, MaxStartPayloadLength); err != nil {
+		return "", err
+	}
+	return startAdminLink(botUsername, "startgroup", payload, rights), nil
+}
+
+// StartChannelLink returns a link prompting the user to add the bot as an
+// administrator of a channel with the given rights.
+func StartChannelLink(botUsername string, rights *ChatAdministratorRights) string {
+	return startAdminLink(botUsername, "startchannel", "", rights)
+}
+
+func startAdminLink(botUsername, param, payload string, rights *ChatAdministratorRights) string {
+	link := "https://t.me/" + botUsername + "?" + param
+	if payload != "" {
+		link += "=" + payload
+	}
+	if admin := adminRightsParam(rights); admin != "" {
+		link += "&admin=" + admin
+	}
+	return link
+}
+
+// adminRightsParam returns the rights as the admin parameter of bot links.
+func adminRightsParam(rights *ChatAdministratorRights) string {
+	if rights == nil {
+		return ""
+	}
+	var names []string
+	for _, right := range []struct {
+		set  bool
+		name string
+	}{
+		{rights.CanChangeInfo, "change_info"},
+		{rights.CanPostMessages, "post_messages"},
+		{rights.CanEditMessages, "edit_messages"},
+		{rights.CanDeleteMessages, "delete_messages"},
+		{rights.CanRestrictMembers, "restrict_members"},
+		{rights.CanInviteUsers, "invite_users"},
+		{rights.CanPinMessages, "pin_messages"},
+		{rights.CanManageTopics, "manage_topics"},
+		{rights.CanPromoteMembers, "promote_members"},
+		{rights.CanManageVideoChats, "manage_video_chats"},
+		{rights.IsAnonymous, "anonymous"},
+		{rights.CanManageChat, "manage_chat"},
+		{rights.CanPostStories, "post_stories"},
+		{rights.CanEditStories, "edit_stories"},
+		{rights.CanDeleteStories, "delete_stories"},
+	} {
+		if right.set {
+			names = append(names, right.name)
+		}
+	}
+	return strings.Join(names, "+")
+}
+
+// StartAppLink returns a link opening the Mini App appName of the bot, or its
+// main Mini App if appName is empty. The app receives payload as start_param.
+// It fails if payload isn't a valid start parameter.
+func StartAppLink(botUsername, appName, payload string) (string, error) {
+	if err := validateStartPayload(payload, MaxStartAppPayloadLength); err != nil {
+		return "", err
+	}
+	link := "https://t.me/" + botUsername
+	if appName != "" {
+		link += "/" + appName
+	}
+	if payload != "" || appName == "" {
+		link += "?startapp=" + payload
+	}
+	return link, nil
+}
+
+// UserLink returns a link to a user with a username. Users without one can be
+// mentioned with a text_mention entity, or a link to "tg://user?id=<id>".
+func UserLink(user *User) string {
+	if user.UserName != "" {
+		return "https://t.me/" + user.UserName
+	}
+	return fmt.Sprintf("tg://user?id=%d", user.ID)
+}
+
+// ChatLink returns a link to a public chat, or "" for a chat without username.
+func ChatLink(chat *Chat) string {
+	if chat.UserName == "" {
+		return ""
+	}
+	return "https://t.me/" + chat.UserName
+}
+
+// MessageLink returns a link to a message in a supergroup or channel.
+// Links to messages of private chats are only accessible to their members.
+func MessageLink(chat *Chat, messageID int64) string {
+	if chat.UserName != "" {
+		return fmt.Sprintf("https://t.me/%s/%d", chat.UserName, messageID)
+	}
+	// Strip the -100 prefix of supergroup and channel identifiers
+	id := strings.TrimPrefix(fmt.Sprint(chat.ID), "-100")
+	return fmt.Sprintf("https://t.me/c/%s/%d", id, messageID)
+}
+
+// EncodeStartPayload encodes arbitrary data into a start parameter, which
+// fits in MaxStartPayloadLength characters for up to 48 bytes of data.
+func EncodeStartPayload(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeStartPayload decodes a start parameter created by EncodeStartPayload.
+func DecodeStartPayload(payload string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(payload)
+}
+
+// Command returns the command of a message starting with a bot command, without
+// its "/" and "@botname" suffix, along with the rest of the text as args.
+// It returns empty strings if the message doesn't start with a command.
+// Commands addressed to any bot are returned, see CommandFor.
+func (m *Message) Command() (command, args string) {
+	return m.CommandFor("")
+}
+
+// CommandFor is like Command, but returns empty strings for commands addressed
+// to another bot than the one with the given username, such as "/help@otherbot"
+// in a group. An empty username accepts commands addressed to any bot.
+func (m *Message) CommandFor(username string) (command, args string) {
+	for _, e := range m.Entities {
+		if e.Type != EntityTypeBotCommand || e.Offset != 0 {
+			continue
+		}
+		var botname string
+		var addressed bool
+		command = strings.TrimPrefix(e.Extract(m.Text), "/")
+		command, botname, addressed = strings.Cut(command, "@")
+		if addressed && username != "" && !strings.EqualFold(botname, strings.TrimPrefix(username, "@")) {
+			return "", ""
+		}
+		args = strings.TrimSpace(string([]rune(m.Text)[len([]rune(e.Extract(m.Text))):]))
+		return
+	}
+	return "", ""
+}
+
+// StartPayload returns the payload of a "/start payload" message sent from a
+// deep link, "" for other messages. See StartPayloadFor to ignore the commands
+// addressed to other bots.
+func (m *Message) StartPayload() string {
+	return m.StartPayloadFor("")
+}
+
+// StartPayloadFor is like StartPayload, but only accepts the /start command
+// addressed to the bot with the given username, see CommandFor.
+func (m *Message) StartPayloadFor(username string) string {
+	if command, args := m.CommandFor(username); command == "start" {
+		return args
+	}
+	return ""
+}
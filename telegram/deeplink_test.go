@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStartPayload(t *testing.T) {
+	payload := EncodeStartPayload([]byte("ref=42"))
+	link, err := StartLink("my_bot", payload)
+	if err != nil || link != "https://t.me/my_bot?start="+payload {
+		t.Errorf("link: got %s, %v", link, err)
+	}
+	text := "/start@my_bot " + payload
+	msg := &Message{Text: text, Entities: []*MessageEntity{{Type: EntityTypeBotCommand, Offset: 0, Length: 13}}}
+	if msg.StartPayloadFor("other_bot") != "" || msg.StartPayloadFor("my_bot") != payload {
+		t.Errorf("payload for: got %q", msg.StartPayloadFor("my_bot"))
+	}
+	router := NewRouter()
+	router.Username = "My_Bot"
+	var got []byte
+	router.OnStartPayload(func(message *Message, data []byte) error {
+		got = data
+		return nil
+	})
+	router.HandleUpdate(&Update{Message: msg}, nil)
+	if string(got) != "ref=42" {
+		t.Errorf("payload: got %q", got)
+	}
+}
+
+func TestStartLinkInvalidPayload(t *testing.T) {
+	for _, payload := range []string{"ref=42", "a b", strings.Repeat("a", MaxStartPayloadLength+1)} {
+		if link, err := StartLink("my_bot", payload); err == nil {
+			t.Errorf("%q: got %s", payload, link)
+		}
+		if link, err := StartGroupLink("my_bot", payload, nil); err == nil {
+			t.Errorf("%q: got %s", payload, link)
+		}
+	}
+	if link, err := StartAppLink("my_bot", "app", strings.Repeat("a", MaxStartPayloadLength+1)); err != nil {
+		t.Errorf("long app payload: %v", err)
+	} else if link != "https://t.me/my_bot/app?startapp="+strings.Repeat("a", MaxStartPayloadLength+1) {
+		t.Errorf("got %s", link)
+	}
+	if _, err := StartAppLink("my_bot", "", "a/b"); err == nil {
+		t.Error("expected an error for an invalid app payload")
+	}
+}
+
+func TestCommandFor(t *testing.T) {
+	msg := &Message{Text: "/help@other_bot now", Entities: []*MessageEntity{{Type: EntityTypeBotCommand, Offset: 0, Length: 15}}}
+	if command, _ := msg.CommandFor("my_bot"); command != "" {
+		t.Errorf("command addressed to another bot: got %q", command)
+	}
+	if command, args := msg.CommandFor(""); command != "help" || args != "now" {
+		t.Errorf("got %q, %q", command, args)
+	}
+	msg = &Message{Text: "/help", Entities: []*MessageEntity{{Type: EntityTypeBotCommand, Offset: 0, Length: 5}}}
+	if command, _ := msg.CommandFor("my_bot"); command != "help" {
+		t.Errorf("command without username: got %q", command)
+	}
+}
+
+func TestStartGroupLink(t *testing.T) {
+	rights := &ChatAdministratorRights{CanDeleteMessages: true, CanRestrictMembers: true}
+	got, err := StartGroupLink("my_bot", "", rights)
+	if err != nil || got != "https://t.me/my_bot?startgroup&admin=delete_messages+restrict_members" {
+		t.Errorf("got %s", got)
+	}
+}
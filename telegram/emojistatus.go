@@ -0,0 +1,33 @@
+package telegram
+
+import "time"
+
+type UserEmojiStatusRequest struct {
+	UserID int64 `json:"user_id"`
+	// Custom emoji identifier of the emoji status, empty to remove the status
+	EmojiStatusCustomEmojiID string `json:"emoji_status_custom_emoji_id,omitempty"`
+	// Unix time when the status expires, if any
+	EmojiStatusExpirationDate int64 `json:"emoji_status_expiration_date,omitempty"`
+}
+
+// SetUserEmojiStatus changes the emoji status of a user who previously allowed the bot
+// to manage it via the Mini App method requestEmojiStatusAccess.
+// https://core.telegram.org/bots/api#setuseremojistatus
+func (bot *TelegramBot) SetUserEmojiStatus(req *UserEmojiStatusRequest) error {
+	return bot.CallMethod("setUserEmojiStatus", req, nil)
+}
+
+// SetEmojiStatusFor sets the emoji status of a user for duration d, or with
+// no expiration if d is 0.
+func (bot *TelegramBot) SetEmojiStatusFor(userID int64, customEmojiID string, d time.Duration) error {
+	req := &UserEmojiStatusRequest{UserID: userID, EmojiStatusCustomEmojiID: customEmojiID}
+	if d > 0 {
+		req.EmojiStatusExpirationDate = time.Now().Add(d).Unix()
+	}
+	return bot.SetUserEmojiStatus(req)
+}
+
+// ClearEmojiStatus removes the emoji status of a user.
+func (bot *TelegramBot) ClearEmojiStatus(userID int64) error {
+	return bot.SetUserEmojiStatus(&UserEmojiStatusRequest{UserID: userID})
+}
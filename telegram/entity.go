@@ -0,0 +1,70 @@
+package telegram
+
+import "unicode/utf16"
+
+// MessageEntity represents one special entity in a text message.
+// For example, hashtags, usernames, URLs, etc.
+// @docs https://core.telegram.org/bots/api#messageentity
+type MessageEntity struct {
+	Type          EntityType `json:"type"`
+	Offset        int        `json:"offset"` // Offset in UTF-16 code units to the start of the entity
+	Length        int        `json:"length"` // Length of the entity in UTF-16 code units
+	URL           string     `json:"url,omitempty"`
+	User          *User      `json:"user,omitempty"`
+	Language      string     `json:"language,omitempty"`
+	CustomEmojiID string     `json:"custom_emoji_id,omitempty"`
+}
+
+// Extract returns the part of text covered by the entity.
+// Offsets and lengths are counted in UTF-16 code units, so the text is
+// converted before slicing. Out-of-range entities yield an empty string.
+func (e *MessageEntity) Extract(text string) string {
+	units := utf16.Encode([]rune(text))
+	start, end := e.Offset, e.Offset+e.Length
+	if start < 0 || e.Length < 0 || end > len(units) {
+		return ""
+	}
+	return string(utf16.Decode(units[start:end]))
+}
+
+// UTF16Len returns the length of s in UTF-16 code units, the unit used by
+// entity offsets and by Telegram's message length limits.
+func UTF16Len(s string) (n int) {
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return
+}
+
+// EntityText returns the text of an entity found in message.Entities.
+func (m *Message) EntityText(e *MessageEntity) string {
+	return e.Extract(m.Text)
+}
+
+// CaptionEntityText returns the text of an entity found in message.CaptionEntities.
+func (m *Message) CaptionEntityText(e *MessageEntity) string {
+	if m.Caption == nil {
+		return ""
+	}
+	return e.Extract(*m.Caption)
+}
+
+// EntitiesOfType returns the text of every entity of the given type
+// (e.g. "hashtag", "url", "bot_command") in the message text and caption.
+func (m *Message) EntitiesOfType(entityType EntityType) (values []string) {
+	for _, e := range m.Entities {
+		if e.Type == entityType {
+			values = append(values, m.EntityText(e))
+		}
+	}
+	for _, e := range m.CaptionEntities {
+		if e.Type == entityType {
+			values = append(values, m.CaptionEntityText(e))
+		}
+	}
+	return
+}
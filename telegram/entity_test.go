@@ -0,0 +1,57 @@
+package telegram
+
+import "testing"
+
+func TestEntityExtractASCII(t *testing.T) {
+	e := &MessageEntity{Type: "bold", Offset: 6, Length: 5}
+	if got := e.Extract("Hello world"); got != "world" {
+		t.Errorf("got %q, want %q", got, "world")
+	}
+}
+
+func TestEntityExtractSurrogatePairs(t *testing.T) {
+	// "😀" is two UTF-16 code units, "é" is one.
+	text := "😀 café #go"
+	e := &MessageEntity{Type: "hashtag", Offset: 8, Length: 3}
+	if got := e.Extract(text); got != "#go" {
+		t.Errorf("got %q, want %q", got, "#go")
+	}
+	e = &MessageEntity{Type: "bold", Offset: 0, Length: 2}
+	if got := e.Extract(text); got != "😀" {
+		t.Errorf("got %q, want %q", got, "😀")
+	}
+}
+
+func TestEntityExtractOutOfRange(t *testing.T) {
+	e := &MessageEntity{Offset: 3, Length: 10}
+	if got := e.Extract("short"); got != "" {
+		t.Errorf("got %q, want empty", got)
+	}
+}
+
+func TestUTF16Len(t *testing.T) {
+	if n := UTF16Len("a😀é"); n != 4 {
+		t.Errorf("got %d, want 4", n)
+	}
+}
+
+func TestEntitiesOfType(t *testing.T) {
+	caption := "see 🔗 https://example.com"
+	m := &Message{
+		Text: "/start 🎉 #news",
+		Entities: []*MessageEntity{
+			{Type: "bot_command", Offset: 0, Length: 6},
+			{Type: "hashtag", Offset: 10, Length: 5},
+		},
+		Caption: &caption,
+		CaptionEntities: []*MessageEntity{
+			{Type: "url", Offset: 7, Length: 19},
+		},
+	}
+	if got := m.EntitiesOfType("hashtag"); len(got) != 1 || got[0] != "#news" {
+		t.Errorf("hashtags: got %q", got)
+	}
+	if got := m.EntitiesOfType("url"); len(got) != 1 || got[0] != "https://example.com" {
+		t.Errorf("urls: got %q", got)
+	}
+}
@@ -0,0 +1,87 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ResponseParameters describes why a request was unsuccessful.
+// @docs https://core.telegram.org/bots/api#responseparameters
+type ResponseParameters struct {
+	// The group has been migrated to a supergroup with the specified identifier.
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	// In case of exceeding flood control, the number of seconds left to wait before the request can be repeated.
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+// Error is an unsuccessful response of the Bot API.
+type Error struct {
+	Code        int
+	Description string
+	Parameters  *ResponseParameters
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("error: %d %s", e.Code, e.Description)
+}
+
+// Is matches the sentinel errors below against the error description.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrMessageNotModified:
+		return e.Code == 400 && strings.Contains(e.Description, "message is not modified")
+	case ErrMessageNotFound:
+		return e.Code == 400 && strings.Contains(e.Description, "message to edit not found")
+	case ErrMessageCantBeEdited:
+		return e.Code == 400 && strings.Contains(e.Description, "message can't be edited")
+	case ErrScoreNotModified:
+		return e.Code == 400 && strings.Contains(e.Description, "BOT_SCORE_NOT_MODIFIED")
+	case ErrBotBlocked:
+		return e.Code == 403 && strings.Contains(e.Description, "bot was blocked by the user")
+	case ErrBotKicked:
+		return e.Code == 403 && strings.Contains(e.Description, "bot was kicked")
+	case ErrUserDeactivated:
+		return e.Code == 403 && strings.Contains(e.Description, "user is deactivated")
+	case ErrChatNotFound:
+		return e.Code == 400 && strings.Contains(e.Description, "chat not found")
+	case ErrNotEnoughRights:
+		return strings.Contains(e.Description, "not enough rights")
+	case ErrCantSetStickerSet:
+		return e.Code == 400 && (strings.Contains(e.Description, "STICKERSET_INVALID") ||
+			strings.Contains(e.Description, "can't set") && strings.Contains(e.Description, "sticker set"))
+	}
+	return false
+}
+
+// isTemporary reports whether a request failing with err may succeed later:
+// flood waits, server errors and errors reaching the Bot API.
+func isTemporary(err error) bool {
+	var apiErr *Error
+	return !errors.As(err, &apiErr) || apiErr.Code == 429 || apiErr.Code >= 500
+}
+
+var (
+	// ErrMessageNotModified is returned when editing a message with the content and reply markup it already has.
+	ErrMessageNotModified = errors.New("telegram: message is not modified")
+	// ErrMessageNotFound is returned when editing a message which was deleted or never existed.
+	ErrMessageNotFound = errors.New("telegram: message to edit not found")
+	// ErrMessageCantBeEdited is returned when editing a message the bot can't edit anymore.
+	ErrMessageCantBeEdited = errors.New("telegram: message can't be edited")
+	// ErrScoreNotModified is returned by SetGameScore when the new score isn't greater
+	// than the user's current one and Force isn't set.
+	ErrScoreNotModified = errors.New("telegram: game score is not modified")
+	// ErrBotBlocked is returned when sending a message to a user who blocked the bot.
+	ErrBotBlocked = errors.New("telegram: bot was blocked by the user")
+	// ErrBotKicked is returned when sending a message to a group or channel the bot was removed from.
+	ErrBotKicked = errors.New("telegram: bot was kicked from the chat")
+	// ErrUserDeactivated is returned when sending a message to a deleted account.
+	ErrUserDeactivated = errors.New("telegram: user is deactivated")
+	// ErrChatNotFound is returned for unknown chats, or chats the bot never talked to.
+	ErrChatNotFound = errors.New("telegram: chat not found")
+	// ErrNotEnoughRights is returned when the bot lacks the administrator rights required by a method.
+	ErrNotEnoughRights = errors.New("telegram: not enough rights")
+	// ErrCantSetStickerSet is returned by SetChatStickerSet when the sticker set is invalid or the
+	// supergroup can't have one, see ChatFullInfo.CanSetStickerSet.
+	ErrCantSetStickerSet = errors.New("telegram: can't set the chat sticker set")
+)
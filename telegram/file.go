@@ -0,0 +1,101 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// ProgressFunc is called while a file is transferred with the number of bytes
+// transferred so far and the total size, which is 0 if unknown.
+type ProgressFunc func(transferred, total int64)
+
+// progressWriter counts bytes written through it and reports them.
+type progressWriter struct {
+	w           io.Writer
+	transferred int64
+	total       int64
+	progress    ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (n int, err error) {
+	n, err = p.w.Write(b)
+	p.transferred += int64(n)
+	if p.progress != nil {
+		p.progress(p.transferred, p.total)
+	}
+	return
+}
+
+type GetFileRequest struct {
+	FileID string `json:"file_id"`
+}
+
+// GetFile gets basic information about a file and prepares it for downloading.
+// For the moment, bots can download files of up to 20MB in size.
+// The file can then be downloaded with DownloadFile, or from FileDownloadURL.
+// https://core.telegram.org/bots/api#getfile
+func (bot *TelegramBot) GetFile(fileID string) (file *File, err error) {
+	err = bot.CallMethod("getFile", &GetFileRequest{FileID: fileID}, &file)
+	return
+}
+
+// FileDownloadURL returns the link for downloading a file returned by GetFile.
+// The link is valid for at least 1 hour and contains the bot token, so don't share it.
+func (bot *TelegramBot) FileDownloadURL(file *File) string {
+	return bot.apiURL() + "/file/bot" + bot.config.Token + "/" + file.FilePath
+}
+
+// DownloadFile streams the content of the file to w.
+// progress may be nil. When the bot talks to a local Bot API server,
+// which returns absolute file paths, the file is read from disk.
+func (bot *TelegramBot) DownloadFile(ctx context.Context, fileID string, w io.Writer, progress ProgressFunc) (file *File, err error) {
+	file, err = bot.GetFile(fileID)
+	if err != nil {
+		return
+	}
+	var body io.ReadCloser
+	if filepath.IsAbs(file.FilePath) {
+		body, err = os.Open(file.FilePath)
+		if err != nil {
+			return
+		}
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, bot.FileDownloadURL(file), nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := bot.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return nil, fmt.Errorf("error: download %s: %s", file.FileID, res.Status)
+		}
+		if file.FileSize == 0 && res.ContentLength > 0 {
+			file.FileSize = res.ContentLength
+		}
+		body = res.Body
+	}
+	defer body.Close()
+	pw := &progressWriter{w: w, total: file.FileSize, progress: progress}
+	_, err = io.Copy(pw, &contextReader{ctx: ctx, r: body})
+	return
+}
+
+// contextReader stops reading once ctx is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -46,8 +46,11 @@ func ToFormValues(v any) map[string]string {
 		if hasOmitEmpty && isZero {
 			continue
 		}
-		// JSON stringify complex types
-		if isComplexType(fieldVal) {
+		// Files are referenced by their file_id, URL or attach:// name
+		if f, ok := value.(*InputFile); ok && f != nil {
+			result[key] = f.String()
+		} else if isComplexType(fieldVal) {
+			// JSON stringify complex types
 			jsonBytes, err := json.Marshal(value)
 			if err == nil {
 				result[key] = string(jsonBytes)
@@ -96,3 +99,28 @@ func isComplexType(v reflect.Value) bool {
 		return false
 	}
 }
+
+// marshalUnion encodes v as a JSON object with an additional "type"
+// discriminator field, as used by the union types of the Bot API.
+// v must not implement json.Marshaler itself (pass an alias type).
+func marshalUnion(typ string, v any) ([]byte, error) {
+	return marshalTagged("type", typ, v)
+}
+
+// marshalTagged is like marshalUnion with a discriminator field named key.
+func marshalTagged(key, value string, v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	head, err := json.Marshal(map[string]string{key: value})
+	if err != nil {
+		return nil, err
+	}
+	out := head[:len(head)-1]
+	if string(data) == "{}" {
+		return append(out, '}'), nil
+	}
+	out = append(out, ',')
+	return append(out, data[1:]...), nil
+}
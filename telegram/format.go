@@ -0,0 +1,98 @@
+package telegram
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	markdownV2Replacer = newEscapeReplacer("_*[]()~`>#+-=|{}.!\\")
+	markdownReplacer   = newEscapeReplacer("_*`[")
+	htmlReplacer       = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// newEscapeReplacer returns a replacer prefixing each of chars with a backslash.
+func newEscapeReplacer(chars string) *strings.Replacer {
+	var pairs []string
+	for _, c := range chars {
+		pairs = append(pairs, string(c), "\\"+string(c))
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// EscapeMarkdownV2 escapes s to be displayed as is in a ParseModeMarkdownV2 text.
+// Inside pre and code entities, use EscapeMarkdownV2Code instead.
+// @docs https://core.telegram.org/bots/api#markdownv2-style
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Replacer.Replace(s)
+}
+
+// EscapeMarkdownV2Code escapes s for use inside a MarkdownV2 pre or code entity,
+// where only '`' and '\' have to be escaped.
+func EscapeMarkdownV2Code(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "`", "\\`").Replace(s)
+}
+
+// EscapeMarkdownV2URL escapes s for use as the URL of a MarkdownV2 inline link,
+// where only ')' and '\' have to be escaped.
+func EscapeMarkdownV2URL(s string) string {
+	return strings.NewReplacer("\\", "\\\\", ")", "\\)").Replace(s)
+}
+
+// EscapeMarkdown escapes s for the legacy ParseModeMarkdown.
+func EscapeMarkdown(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// EscapeHTML escapes s to be displayed as is in a ParseModeHTML text.
+// @docs https://core.telegram.org/bots/api#html-style
+func EscapeHTML(s string) string {
+	return htmlReplacer.Replace(s)
+}
+
+// Escape escapes s for the given parse mode. Without a parse mode s is returned unchanged.
+func Escape(mode ParseMode, s string) string {
+	switch mode {
+	case ParseModeMarkdownV2:
+		return EscapeMarkdownV2(s)
+	case ParseModeMarkdown:
+		return EscapeMarkdown(s)
+	case ParseModeHTML:
+		return EscapeHTML(s)
+	}
+	return s
+}
+
+// Sprintf formats according to format, escaping every formatted argument for
+// the parse mode while the format itself is kept as is. This allows mixing
+// markup with user-provided values:
+//
+//	telegram.Sprintf(telegram.ParseModeMarkdownV2, "*%s* scored %.1f", name, score)
+func Sprintf(mode ParseMode, format string, args ...any) string {
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		escaped[i] = escapedArg{mode: mode, value: arg}
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+// MarkdownV2f is Sprintf with ParseModeMarkdownV2.
+func MarkdownV2f(format string, args ...any) string {
+	return Sprintf(ParseModeMarkdownV2, format, args...)
+}
+
+// HTMLf is Sprintf with ParseModeHTML.
+func HTMLf(format string, args ...any) string {
+	return Sprintf(ParseModeHTML, format, args...)
+}
+
+// escapedArg formats its value with the verb used, then escapes the result.
+type escapedArg struct {
+	mode  ParseMode
+	value any
+}
+
+func (a escapedArg) Format(f fmt.State, verb rune) {
+	io.WriteString(f, Escape(a.mode, fmt.Sprintf(fmt.FormatString(f, verb), a.value)))
+}
@@ -0,0 +1,28 @@
+package telegram
+
+import "testing"
+
+func TestEscapeMarkdownV2(t *testing.T) {
+	got := EscapeMarkdownV2("1.5 * (a-b) = c_d!")
+	want := `1\.5 \* \(a\-b\) \= c\_d\!`
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestEscapeHTML(t *testing.T) {
+	if got := EscapeHTML(`<a href="x">&</a>`); got != "&lt;a href=&quot;x&quot;&gt;&amp;&lt;/a&gt;" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestSprintf(t *testing.T) {
+	got := MarkdownV2f("*%s* scored %d %.1f%%", "john_doe", -3, 2.5)
+	want := `*john\_doe* scored \-3 2\.5%`
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := HTMLf("<b>%v</b>", "a<b"); got != "<b>a&lt;b</b>" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,130 @@
+package telegram
+
+// ForumTopic represents a forum topic.
+// @docs https://core.telegram.org/bots/api#forumtopic
+type ForumTopic struct {
+	MessageThreadID   int64  `json:"message_thread_id"`
+	Name              string `json:"name"`
+	IconColor         int    `json:"icon_color"` // RGB
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+	IsNameImplicit    bool   `json:"is_name_implicit,omitempty"` // The name was not specified explicitly by its creator
+}
+
+// Colors allowed for the icon of a new forum topic.
+const (
+	ForumTopicColorBlue   = 0x6FB9F0
+	ForumTopicColorYellow = 0xFFD67E
+	ForumTopicColorViolet = 0xCB86DB
+	ForumTopicColorGreen  = 0x8EEE98
+	ForumTopicColorRose   = 0xFF93B2
+	ForumTopicColorRed    = 0xFB6F5F
+)
+
+type CreateForumTopicRequest struct {
+	ChatID            any    `json:"chat_id"`
+	Name              string `json:"name"`                           // 1-128 characters
+	IconColor         int    `json:"icon_color,omitempty"`           // One of the ForumTopicColor constants
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"` // See GetForumTopicIconStickers
+}
+
+// CreateForumTopic creates a topic in a forum supergroup chat.
+// The bot must be an administrator in the chat with the can_manage_topics right.
+// https://core.telegram.org/bots/api#createforumtopic
+func (bot *TelegramBot) CreateForumTopic(req *CreateForumTopicRequest) (topic *ForumTopic, err error) {
+	err = bot.CallMethod("createForumTopic", req, &topic)
+	return
+}
+
+type EditForumTopicRequest struct {
+	ChatID          any    `json:"chat_id"`
+	MessageThreadID int64  `json:"message_thread_id"`
+	Name            string `json:"name,omitempty"` // Kept if empty
+	// Kept if nil, pass a pointer to an empty string to remove the icon
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// EditForumTopic edits the name and icon of a topic in a forum supergroup chat.
+// https://core.telegram.org/bots/api#editforumtopic
+func (bot *TelegramBot) EditForumTopic(req *EditForumTopicRequest) error {
+	return bot.CallMethod("editForumTopic", req, nil)
+}
+
+type ForumTopicRequest struct {
+	ChatID          any   `json:"chat_id"`
+	MessageThreadID int64 `json:"message_thread_id"`
+}
+
+// CloseForumTopic closes an open topic in a forum supergroup chat.
+// https://core.telegram.org/bots/api#closeforumtopic
+func (bot *TelegramBot) CloseForumTopic(chatID any, messageThreadID int64) error {
+	return bot.CallMethod("closeForumTopic", &ForumTopicRequest{ChatID: chatID, MessageThreadID: messageThreadID}, nil)
+}
+
+// ReopenForumTopic reopens a closed topic in a forum supergroup chat.
+// https://core.telegram.org/bots/api#reopenforumtopic
+func (bot *TelegramBot) ReopenForumTopic(chatID any, messageThreadID int64) error {
+	return bot.CallMethod("reopenForumTopic", &ForumTopicRequest{ChatID: chatID, MessageThreadID: messageThreadID}, nil)
+}
+
+// DeleteForumTopic deletes a forum topic along with all its messages.
+// The bot must have the can_delete_messages administrator right.
+// https://core.telegram.org/bots/api#deleteforumtopic
+func (bot *TelegramBot) DeleteForumTopic(chatID any, messageThreadID int64) error {
+	return bot.CallMethod("deleteForumTopic", &ForumTopicRequest{ChatID: chatID, MessageThreadID: messageThreadID}, nil)
+}
+
+// UnpinAllForumTopicMessages clears the list of pinned messages in a forum topic.
+// https://core.telegram.org/bots/api#unpinallforumtopicmessages
+func (bot *TelegramBot) UnpinAllForumTopicMessages(chatID any, messageThreadID int64) error {
+	return bot.CallMethod("unpinAllForumTopicMessages", &ForumTopicRequest{ChatID: chatID, MessageThreadID: messageThreadID}, nil)
+}
+
+type EditGeneralForumTopicRequest struct {
+	ChatID any    `json:"chat_id"`
+	Name   string `json:"name"`
+}
+
+// EditGeneralForumTopic edits the name of the "General" topic in a forum supergroup chat.
+// https://core.telegram.org/bots/api#editgeneralforumtopic
+func (bot *TelegramBot) EditGeneralForumTopic(chatID any, name string) error {
+	return bot.CallMethod("editGeneralForumTopic", &EditGeneralForumTopicRequest{ChatID: chatID, Name: name}, nil)
+}
+
+// CloseGeneralForumTopic closes the open "General" topic in a forum supergroup chat.
+// https://core.telegram.org/bots/api#closegeneralforumtopic
+func (bot *TelegramBot) CloseGeneralForumTopic(chatID any) error {
+	return bot.CallMethod("closeGeneralForumTopic", &ChatRequest{ChatID: chatID}, nil)
+}
+
+// ReopenGeneralForumTopic reopens the closed "General" topic in a forum supergroup chat.
+// The topic will be automatically unhidden if it was hidden.
+// https://core.telegram.org/bots/api#reopengeneralforumtopic
+func (bot *TelegramBot) ReopenGeneralForumTopic(chatID any) error {
+	return bot.CallMethod("reopenGeneralForumTopic", &ChatRequest{ChatID: chatID}, nil)
+}
+
+// HideGeneralForumTopic hides the "General" topic in a forum supergroup chat.
+// The topic will be automatically closed if it was open.
+// https://core.telegram.org/bots/api#hidegeneralforumtopic
+func (bot *TelegramBot) HideGeneralForumTopic(chatID any) error {
+	return bot.CallMethod("hideGeneralForumTopic", &ChatRequest{ChatID: chatID}, nil)
+}
+
+// UnhideGeneralForumTopic unhides the "General" topic in a forum supergroup chat.
+// https://core.telegram.org/bots/api#unhidegeneralforumtopic
+func (bot *TelegramBot) UnhideGeneralForumTopic(chatID any) error {
+	return bot.CallMethod("unhideGeneralForumTopic", &ChatRequest{ChatID: chatID}, nil)
+}
+
+// UnpinAllGeneralForumTopicMessages clears the list of pinned messages in the "General" topic.
+// https://core.telegram.org/bots/api#unpinallgeneralforumtopicmessages
+func (bot *TelegramBot) UnpinAllGeneralForumTopicMessages(chatID any) error {
+	return bot.CallMethod("unpinAllGeneralForumTopicMessages", &ChatRequest{ChatID: chatID}, nil)
+}
+
+// GetForumTopicIconStickers gets the custom emoji stickers which can be used as a forum topic icon by any user.
+// https://core.telegram.org/bots/api#getforumtopiciconstickers
+func (bot *TelegramBot) GetForumTopicIconStickers() (stickers []*Sticker, err error) {
+	err = bot.CallMethod("getForumTopicIconStickers", nil, &stickers)
+	return
+}
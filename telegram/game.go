@@ -0,0 +1,78 @@
+package telegram
+
+// CallbackGame is a placeholder, currently holding no information.
+// Use BotFather to set up your game.
+// @docs https://core.telegram.org/bots/api#callbackgame
+type CallbackGame struct{}
+
+// GameHighScore represents one row of the high scores table for a game.
+// @docs https://core.telegram.org/bots/api#gamehighscore
+type GameHighScore struct {
+	Position int   `json:"position"`
+	User     *User `json:"user"`
+	Score    int   `json:"score"`
+}
+
+type SendGameRequest struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               int64            `json:"chat_id"`
+	MessageThreadID      int64            `json:"message_thread_id,omitempty"`
+	GameShortName        string           `json:"game_short_name"` // Set up via @BotFather
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	// If empty, one "Play game_title" button is shown; otherwise its first button must be a PlayButton
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendGame sends a game.
+// https://core.telegram.org/bots/api#sendgame
+func (bot *TelegramBot) SendGame(req *SendGameRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendGame", req, &result)
+	return
+}
+
+type SetGameScoreRequest struct {
+	UserID int64 `json:"user_id"`
+	Score  int   `json:"score"` // Non-negative
+	// Allow the score to decrease, e.g. when fixing mistakes or banning cheaters
+	Force bool `json:"force,omitempty"`
+	// Don't edit the game message to include the current scoreboard
+	DisableEditMessage bool `json:"disable_edit_message,omitempty"`
+	// Address the message either with ChatID and MessageID, or with InlineMessageID
+	ChatID          int64  `json:"chat_id,omitempty"`
+	MessageID       int64  `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// SetGameScore sets the score of a user in a game.
+// For inline messages the returned message is nil.
+// A score not greater than the user's current one returns an error matching
+// ErrScoreNotModified, unless Force is set.
+// https://core.telegram.org/bots/api#setgamescore
+func (bot *TelegramBot) SetGameScore(req *SetGameScoreRequest) (message *Message, err error) {
+	return bot.callEdit("setGameScore", req)
+}
+
+type GameHighScoresRequest struct {
+	UserID          int64  `json:"user_id"`
+	ChatID          int64  `json:"chat_id,omitempty"`
+	MessageID       int64  `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// GetGameHighScores returns the score of the user and several of their neighbors in a game.
+// https://core.telegram.org/bots/api#getgamehighscores
+func (bot *TelegramBot) GetGameHighScores(req *GameHighScoresRequest) (scores []*GameHighScore, err error) {
+	err = bot.CallMethod("getGameHighScores", req, &scores)
+	return
+}
+
+// OpenGame answers the callback query of a Play button with the URL of the game.
+// The URL can carry query parameters identifying the user and the message,
+// to be passed back to SetGameScore.
+func (bot *TelegramBot) OpenGame(query *CallbackQuery, url string) error {
+	return bot.AnswerCallbackQuery(&AnswerCallbackQueryRequest{CallbackQueryID: query.ID, URL: url})
+}
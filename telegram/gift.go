@@ -0,0 +1,114 @@
+package telegram
+
+import "encoding/json"
+
+// Gift represents a gift that can be sent by the bot.
+// @docs https://core.telegram.org/bots/api#gift
+type Gift struct {
+	ID               string   `json:"id"`
+	Sticker          *Sticker `json:"sticker"`
+	StarCount        int      `json:"star_count"`
+	UpgradeStarCount int      `json:"upgrade_star_count,omitempty"` // Stars required to upgrade the gift to a unique one
+	// Limited gifts only
+	TotalCount     int   `json:"total_count,omitempty"`
+	RemainingCount int   `json:"remaining_count,omitempty"`
+	PublisherChat  *Chat `json:"publisher_chat,omitempty"`
+}
+
+// UniqueGiftModel describes the model of a unique gift.
+// @docs https://core.telegram.org/bots/api#uniquegiftmodel
+type UniqueGiftModel struct {
+	Name           string   `json:"name"`
+	Sticker        *Sticker `json:"sticker"`
+	RarityPerMille int      `json:"rarity_per_mille"` // Upgraded gifts receiving the model, per 1000
+}
+
+// UniqueGiftSymbol describes the symbol shown on the pattern of a unique gift.
+// @docs https://core.telegram.org/bots/api#uniquegiftsymbol
+type UniqueGiftSymbol struct {
+	Name           string   `json:"name"`
+	Sticker        *Sticker `json:"sticker"`
+	RarityPerMille int      `json:"rarity_per_mille"`
+}
+
+// UniqueGiftBackdropColors describes the colors of the backdrop of a unique gift, in RGB24 format.
+// @docs https://core.telegram.org/bots/api#uniquegiftbackdropcolors
+type UniqueGiftBackdropColors struct {
+	CenterColor int `json:"center_color"`
+	EdgeColor   int `json:"edge_color"`
+	SymbolColor int `json:"symbol_color"`
+	TextColor   int `json:"text_color"`
+}
+
+// UniqueGiftBackdrop describes the backdrop of a unique gift.
+// @docs https://core.telegram.org/bots/api#uniquegiftbackdrop
+type UniqueGiftBackdrop struct {
+	Name           string                    `json:"name"`
+	Colors         *UniqueGiftBackdropColors `json:"colors"`
+	RarityPerMille int                       `json:"rarity_per_mille"`
+}
+
+// UniqueGift describes a unique gift that was upgraded from a regular gift.
+// @docs https://core.telegram.org/bots/api#uniquegift
+type UniqueGift struct {
+	BaseName      string              `json:"base_name"` // Name of the regular gift it was upgraded from
+	Name          string              `json:"name"`      // Unique name, usable in https://t.me/nft/... links
+	Number        int                 `json:"number"`
+	Model         *UniqueGiftModel    `json:"model"`
+	Symbol        *UniqueGiftSymbol   `json:"symbol"`
+	Backdrop      *UniqueGiftBackdrop `json:"backdrop"`
+	PublisherChat *Chat               `json:"publisher_chat,omitempty"`
+}
+
+// OwnedGift describes a gift received and owned by a user or a chat,
+// either a regular gift in Gift or a unique gift in UniqueGift.
+// @docs https://core.telegram.org/bots/api#ownedgift
+type OwnedGift struct {
+	Type        string      `json:"type"` // "regular" | "unique"
+	Gift        *Gift       `json:"-"`    // "regular" only
+	UniqueGift  *UniqueGift `json:"-"`    // "unique" only
+	OwnedGiftID string      `json:"owned_gift_id,omitempty"`
+	SenderUser  *User       `json:"sender_user,omitempty"`
+	SendDate    int64       `json:"send_date"`
+	IsSaved     bool        `json:"is_saved,omitempty"` // Displayed on the account's profile page
+	// "regular" only
+	Text                    string           `json:"text,omitempty"`
+	Entities                []*MessageEntity `json:"entities,omitempty"`
+	IsPrivate               bool             `json:"is_private,omitempty"`
+	CanBeUpgraded           bool             `json:"can_be_upgraded,omitempty"`
+	WasRefunded             bool             `json:"was_refunded,omitempty"`
+	ConvertStarCount        int              `json:"convert_star_count,omitempty"`
+	PrepaidUpgradeStarCount int              `json:"prepaid_upgrade_star_count,omitempty"`
+	// "unique" only
+	CanBeTransferred  bool  `json:"can_be_transferred,omitempty"`
+	TransferStarCount int   `json:"transfer_star_count,omitempty"`
+	NextTransferDate  int64 `json:"next_transfer_date,omitempty"`
+}
+
+// UnmarshalJSON decodes the gift field into Gift or UniqueGift according to Type.
+func (g *OwnedGift) UnmarshalJSON(data []byte) error {
+	type alias OwnedGift
+	var v struct {
+		*alias
+		Gift json.RawMessage `json:"gift"`
+	}
+	v.alias = (*alias)(g)
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if len(v.Gift) == 0 {
+		return nil
+	}
+	if g.Type == "unique" {
+		return json.Unmarshal(v.Gift, &g.UniqueGift)
+	}
+	return json.Unmarshal(v.Gift, &g.Gift)
+}
+
+// OwnedGifts contains the list of gifts received and owned by a user or a chat.
+// @docs https://core.telegram.org/bots/api#ownedgifts
+type OwnedGifts struct {
+	TotalCount int          `json:"total_count"`
+	Gifts      []*OwnedGift `json:"gifts"`
+	NextOffset string       `json:"next_offset,omitempty"` // Empty for the last page
+}
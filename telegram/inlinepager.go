@@ -0,0 +1,59 @@
+package telegram
+
+import "strconv"
+
+// MaxInlineQueryResults is the maximum number of results in an answer to an inline query.
+const MaxInlineQueryResults = 50
+
+// InlinePageFunc returns up to limit results of the query, starting at offset.
+type InlinePageFunc func(query *InlineQuery, offset, limit int) ([]InlineQueryResult, error)
+
+// InlinePager answers inline queries one page at a time, encoding the position
+// of the next page in next_offset. Its Answer method can be used as the
+// handler of Router.OnInlineQuery.
+type InlinePager struct {
+	bot      *TelegramBot
+	pageSize int
+	fetch    InlinePageFunc
+	// CacheTime and IsPersonal are used for every answer, see AnswerInlineQueryRequest.
+	CacheTime  *int
+	IsPersonal bool
+	// Button, if set, is shown above the results of the first page.
+	Button *InlineQueryResultsButton
+}
+
+// NewInlinePager returns a pager answering with pageSize results per page,
+// at most MaxInlineQueryResults, fetched by fetch.
+func (bot *TelegramBot) NewInlinePager(pageSize int, fetch InlinePageFunc) *InlinePager {
+	if pageSize <= 0 || pageSize > MaxInlineQueryResults {
+		pageSize = MaxInlineQueryResults
+	}
+	return &InlinePager{bot: bot, pageSize: pageSize, fetch: fetch}
+}
+
+// Answer answers query with the page starting at its offset.
+func (p *InlinePager) Answer(query *InlineQuery) error {
+	offset, err := strconv.Atoi(query.Offset)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	// Fetch an extra result to know if there is a next page
+	results, err := p.fetch(query, offset, p.pageSize+1)
+	if err != nil {
+		return err
+	}
+	req := &AnswerInlineQueryRequest{
+		InlineQueryID: query.ID,
+		Results:       results,
+		CacheTime:     p.CacheTime,
+		IsPersonal:    p.IsPersonal,
+	}
+	if len(results) > p.pageSize {
+		req.Results = results[:p.pageSize]
+		req.NextOffset = strconv.Itoa(offset + p.pageSize)
+	}
+	if offset == 0 {
+		req.Button = p.Button
+	}
+	return p.bot.AnswerInlineQuery(req)
+}
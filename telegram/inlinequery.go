@@ -0,0 +1,81 @@
+package telegram
+
+// InlineQuery represents an incoming inline query.
+// When the user sends an empty query, the bot could return some default or trending results.
+// @docs https://core.telegram.org/bots/api#inlinequery
+type InlineQuery struct {
+	ID       string    `json:"id"`
+	From     *User     `json:"from"`
+	Query    string    `json:"query"`  // Up to 256 characters
+	Offset   string    `json:"offset"` // Offset of the results to be returned, controlled by the bot
+	ChatType ChatType  `json:"chat_type,omitempty"`
+	Location *Location `json:"location,omitempty"` // For bots that request user location only
+}
+
+// InlineQueryResultsButton represents a button shown above inline query results.
+// Exactly one of WebApp and StartParameter must be set.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultsbutton
+type InlineQueryResultsButton struct {
+	Text   string      `json:"text"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+	// Deep-linking parameter for the /start message sent to the bot when the user presses the button.
+	// 1-64 characters, only A-Z, a-z, 0-9, _ and - are allowed.
+	StartParameter string `json:"start_parameter,omitempty"`
+}
+
+type AnswerInlineQueryRequest struct {
+	InlineQueryID string              `json:"inline_query_id"`
+	Results       []InlineQueryResult `json:"results"` // No more than 50 results per query are allowed
+	// Maximum amount of time in seconds that the results may be cached on the server,
+	// 300 if nil. Set it to 0 to disable caching.
+	CacheTime  *int `json:"cache_time,omitempty"`
+	IsPersonal bool `json:"is_personal,omitempty"` // Cache the results for the user who sent the query only
+	// Offset sent in the next query of the user for more results, no more results if empty. Up to 64 bytes.
+	NextOffset string                    `json:"next_offset,omitempty"`
+	Button     *InlineQueryResultsButton `json:"button,omitempty"`
+}
+
+// AnswerInlineQuery sends answers to an inline query.
+// https://core.telegram.org/bots/api#answerinlinequery
+func (bot *TelegramBot) AnswerInlineQuery(req *AnswerInlineQueryRequest) error {
+	if req.Results == nil {
+		req.Results = []InlineQueryResult{}
+	}
+	return bot.CallMethod("answerInlineQuery", req, nil)
+}
+
+// ChosenInlineResult represents a result of an inline query that was chosen by the user and sent to their chat partner.
+// It is only sent if inline feedback is enabled with the /setinlinefeedback command of @BotFather.
+// @docs https://core.telegram.org/bots/api#choseninlineresult
+type ChosenInlineResult struct {
+	ResultID string    `json:"result_id"`
+	From     *User     `json:"from"`
+	Location *Location `json:"location,omitempty"`
+	// Identifier of the sent inline message, only set if there is an inline keyboard attached to the message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	Query           string `json:"query"`
+}
+
+// PreparedInlineMessage describes an inline message to be sent by a user of a Mini App.
+// @docs https://core.telegram.org/bots/api#preparedinlinemessage
+type PreparedInlineMessage struct {
+	ID             string `json:"id"`              // Passed to Telegram.WebApp.shareMessage
+	ExpirationDate int64  `json:"expiration_date"` // Unix time, the message can't be used after it
+}
+
+type SavePreparedInlineMessageRequest struct {
+	UserID int64             `json:"user_id"`
+	Result InlineQueryResult `json:"result"`
+	// Chats in which the message can be sent, at least one must be allowed
+	AllowUserChats    bool `json:"allow_user_chats,omitempty"`
+	AllowBotChats     bool `json:"allow_bot_chats,omitempty"`
+	AllowGroupChats   bool `json:"allow_group_chats,omitempty"`
+	AllowChannelChats bool `json:"allow_channel_chats,omitempty"`
+}
+
+// SavePreparedInlineMessage stores a message that can be sent by a user of a Mini App.
+// https://core.telegram.org/bots/api#savepreparedinlinemessage
+func (bot *TelegramBot) SavePreparedInlineMessage(req *SavePreparedInlineMessageRequest) (message *PreparedInlineMessage, err error) {
+	err = bot.CallMethod("savePreparedInlineMessage", req, &message)
+	return
+}
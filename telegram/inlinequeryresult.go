@@ -0,0 +1,536 @@
+package telegram
+
+// InlineQueryResult represents one result of an inline query.
+// Results are marshalled with the "type" discriminator returned by ResultType.
+// @docs https://core.telegram.org/bots/api#inlinequeryresult
+type InlineQueryResult interface {
+	ResultType() string
+}
+
+// InlineQueryResultArticle represents a link to an article or web page.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultarticle
+type InlineQueryResultArticle struct {
+	ID                  string                `json:"id"`
+	Title               string                `json:"title"`
+	InputMessageContent InputMessageContent   `json:"input_message_content"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	URL                 string                `json:"url,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	ThumbnailURL        string                `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`
+}
+
+// InlineQueryResultPhoto represents a link to a photo.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultphoto
+type InlineQueryResultPhoto struct {
+	ID                    string                `json:"id"`
+	PhotoURL              string                `json:"photo_url"`
+	ThumbnailURL          string                `json:"thumbnail_url"`
+	PhotoWidth            int                   `json:"photo_width,omitempty"`
+	PhotoHeight           int                   `json:"photo_height,omitempty"`
+	Title                 string                `json:"title,omitempty"`
+	Description           string                `json:"description,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultGif represents a link to an animated GIF file.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultgif
+type InlineQueryResultGif struct {
+	ID                    string                `json:"id"`
+	GifURL                string                `json:"gif_url"`
+	GifWidth              int                   `json:"gif_width,omitempty"`
+	GifHeight             int                   `json:"gif_height,omitempty"`
+	GifDuration           int                   `json:"gif_duration,omitempty"`
+	ThumbnailURL          string                `json:"thumbnail_url"`
+	ThumbnailMimeType     string                `json:"thumbnail_mime_type,omitempty"`
+	Title                 string                `json:"title,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultMpeg4Gif represents a link to a video animation (H.264/MPEG-4 AVC video without sound).
+// @docs https://core.telegram.org/bots/api#inlinequeryresultmpeg4gif
+type InlineQueryResultMpeg4Gif struct {
+	ID                    string                `json:"id"`
+	Mpeg4URL              string                `json:"mpeg4_url"`
+	Mpeg4Width            int                   `json:"mpeg4_width,omitempty"`
+	Mpeg4Height           int                   `json:"mpeg4_height,omitempty"`
+	Mpeg4Duration         int                   `json:"mpeg4_duration,omitempty"`
+	ThumbnailURL          string                `json:"thumbnail_url"`
+	ThumbnailMimeType     string                `json:"thumbnail_mime_type,omitempty"`
+	Title                 string                `json:"title,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultVideo represents a link to a page containing an embedded video player or a video file.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultvideo
+type InlineQueryResultVideo struct {
+	ID                    string                `json:"id"`
+	VideoURL              string                `json:"video_url"`
+	MimeType              string                `json:"mime_type"`
+	ThumbnailURL          string                `json:"thumbnail_url"`
+	Title                 string                `json:"title"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	VideoWidth            int                   `json:"video_width,omitempty"`
+	VideoHeight           int                   `json:"video_height,omitempty"`
+	VideoDuration         int                   `json:"video_duration,omitempty"`
+	Description           string                `json:"description,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultAudio represents a link to an MP3 audio file.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultaudio
+type InlineQueryResultAudio struct {
+	ID                  string                `json:"id"`
+	AudioURL            string                `json:"audio_url"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	Performer           string                `json:"performer,omitempty"`
+	AudioDuration       int                   `json:"audio_duration,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultVoice represents a link to a voice recording in an .OGG container encoded with OPUS.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultvoice
+type InlineQueryResultVoice struct {
+	ID                  string                `json:"id"`
+	VoiceURL            string                `json:"voice_url"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	VoiceDuration       int                   `json:"voice_duration,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultDocument represents a link to a file (only .PDF and .ZIP files can be sent).
+// @docs https://core.telegram.org/bots/api#inlinequeryresultdocument
+type InlineQueryResultDocument struct {
+	ID                  string                `json:"id"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	DocumentURL         string                `json:"document_url"`
+	MimeType            string                `json:"mime_type"`
+	Description         string                `json:"description,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbnailURL        string                `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`
+}
+
+// InlineQueryResultLocation represents a location on a map.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultlocation
+type InlineQueryResultLocation struct {
+	ID                   string                `json:"id"`
+	Latitude             float64               `json:"latitude"`
+	Longitude            float64               `json:"longitude"`
+	Title                string                `json:"title"`
+	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int                   `json:"live_period,omitempty"`
+	Heading              int                   `json:"heading,omitempty"`
+	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent  InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbnailURL         string                `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth       int                   `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight      int                   `json:"thumbnail_height,omitempty"`
+}
+
+// InlineQueryResultVenue represents a venue.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultvenue
+type InlineQueryResultVenue struct {
+	ID                  string                `json:"id"`
+	Latitude            float64               `json:"latitude"`
+	Longitude           float64               `json:"longitude"`
+	Title               string                `json:"title"`
+	Address             string                `json:"address"`
+	FoursquareID        string                `json:"foursquare_id,omitempty"`
+	FoursquareType      string                `json:"foursquare_type,omitempty"`
+	GooglePlaceID       string                `json:"google_place_id,omitempty"`
+	GooglePlaceType     string                `json:"google_place_type,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbnailURL        string                `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`
+}
+
+// InlineQueryResultContact represents a contact with a phone number.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcontact
+type InlineQueryResultContact struct {
+	ID                  string                `json:"id"`
+	PhoneNumber         string                `json:"phone_number"`
+	FirstName           string                `json:"first_name"`
+	LastName            string                `json:"last_name,omitempty"`
+	VCard               string                `json:"vcard,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+	ThumbnailURL        string                `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth      int                   `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight     int                   `json:"thumbnail_height,omitempty"`
+}
+
+// InlineQueryResultGame represents a Game.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultgame
+type InlineQueryResultGame struct {
+	ID            string                `json:"id"`
+	GameShortName string                `json:"game_short_name"`
+	ReplyMarkup   *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// InlineQueryResultCachedPhoto represents a link to a photo stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedphoto
+type InlineQueryResultCachedPhoto struct {
+	ID                    string                `json:"id"`
+	PhotoFileID           string                `json:"photo_file_id"`
+	Title                 string                `json:"title,omitempty"`
+	Description           string                `json:"description,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedGif represents a link to an animated GIF file stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedgif
+type InlineQueryResultCachedGif struct {
+	ID                    string                `json:"id"`
+	GifFileID             string                `json:"gif_file_id"`
+	Title                 string                `json:"title,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedMpeg4Gif represents a link to a video animation (H.264/MPEG-4 AVC video without sound) stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedmpeg4gif
+type InlineQueryResultCachedMpeg4Gif struct {
+	ID                    string                `json:"id"`
+	Mpeg4FileID           string                `json:"mpeg4_file_id"`
+	Title                 string                `json:"title,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedSticker represents a link to a sticker stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedsticker
+type InlineQueryResultCachedSticker struct {
+	ID                  string                `json:"id"`
+	StickerFileID       string                `json:"sticker_file_id"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedDocument represents a link to a file stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcacheddocument
+type InlineQueryResultCachedDocument struct {
+	ID                  string                `json:"id"`
+	Title               string                `json:"title"`
+	DocumentFileID      string                `json:"document_file_id"`
+	Description         string                `json:"description,omitempty"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedVideo represents a link to a video file stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedvideo
+type InlineQueryResultCachedVideo struct {
+	ID                    string                `json:"id"`
+	VideoFileID           string                `json:"video_file_id"`
+	Title                 string                `json:"title"`
+	Description           string                `json:"description,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent   InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedVoice represents a link to a voice message stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedvoice
+type InlineQueryResultCachedVoice struct {
+	ID                  string                `json:"id"`
+	VoiceFileID         string                `json:"voice_file_id"`
+	Title               string                `json:"title"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultCachedAudio represents a link to an MP3 audio file stored on the Telegram servers.
+// @docs https://core.telegram.org/bots/api#inlinequeryresultcachedaudio
+type InlineQueryResultCachedAudio struct {
+	ID                  string                `json:"id"`
+	AudioFileID         string                `json:"audio_file_id"`
+	Caption             string                `json:"caption,omitempty"`
+	ParseMode           ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities     []*MessageEntity      `json:"caption_entities,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent InputMessageContent   `json:"input_message_content,omitempty"`
+}
+
+func (r *InlineQueryResultArticle) ResultType() string        { return "article" }
+func (r *InlineQueryResultPhoto) ResultType() string          { return "photo" }
+func (r *InlineQueryResultGif) ResultType() string            { return "gif" }
+func (r *InlineQueryResultMpeg4Gif) ResultType() string       { return "mpeg4_gif" }
+func (r *InlineQueryResultVideo) ResultType() string          { return "video" }
+func (r *InlineQueryResultAudio) ResultType() string          { return "audio" }
+func (r *InlineQueryResultVoice) ResultType() string          { return "voice" }
+func (r *InlineQueryResultDocument) ResultType() string       { return "document" }
+func (r *InlineQueryResultLocation) ResultType() string       { return "location" }
+func (r *InlineQueryResultVenue) ResultType() string          { return "venue" }
+func (r *InlineQueryResultContact) ResultType() string        { return "contact" }
+func (r *InlineQueryResultGame) ResultType() string           { return "game" }
+func (r *InlineQueryResultCachedPhoto) ResultType() string    { return "photo" }
+func (r *InlineQueryResultCachedGif) ResultType() string      { return "gif" }
+func (r *InlineQueryResultCachedMpeg4Gif) ResultType() string { return "mpeg4_gif" }
+func (r *InlineQueryResultCachedSticker) ResultType() string  { return "sticker" }
+func (r *InlineQueryResultCachedDocument) ResultType() string { return "document" }
+func (r *InlineQueryResultCachedVideo) ResultType() string    { return "video" }
+func (r *InlineQueryResultCachedVoice) ResultType() string    { return "voice" }
+func (r *InlineQueryResultCachedAudio) ResultType() string    { return "audio" }
+
+func (r *InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultArticle
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultPhoto
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGif
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultMpeg4Gif
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVideo
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultAudio
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVoice
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultDocument
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultLocation
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVenue
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultContact
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGame
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedPhoto
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedGif
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedMpeg4Gif
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedSticker
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedDocument
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedVideo
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedVoice
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+func (r *InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedAudio
+	return marshalUnion(r.ResultType(), (*alias)(r))
+}
+
+// NewInlineQueryResultArticle creates an article that sends content when chosen.
+func NewInlineQueryResultArticle(id, title string, content InputMessageContent) *InlineQueryResultArticle {
+	return &InlineQueryResultArticle{ID: id, Title: title, InputMessageContent: content}
+}
+
+// NewInlineQueryResultPhoto creates a photo result.
+// The photo itself is used as thumbnail; set ThumbnailURL to use a smaller one.
+func NewInlineQueryResultPhoto(id, photoURL string) *InlineQueryResultPhoto {
+	return &InlineQueryResultPhoto{ID: id, PhotoURL: photoURL, ThumbnailURL: photoURL}
+}
+
+// NewInlineQueryResultGif creates a GIF result, using the GIF as its own thumbnail.
+func NewInlineQueryResultGif(id, gifURL string) *InlineQueryResultGif {
+	return &InlineQueryResultGif{ID: id, GifURL: gifURL, ThumbnailURL: gifURL}
+}
+
+// NewInlineQueryResultMpeg4Gif creates an MPEG-4 animation result.
+func NewInlineQueryResultMpeg4Gif(id, mpeg4URL, thumbnailURL string) *InlineQueryResultMpeg4Gif {
+	return &InlineQueryResultMpeg4Gif{ID: id, Mpeg4URL: mpeg4URL, ThumbnailURL: thumbnailURL}
+}
+
+// NewInlineQueryResultVideo creates a result for an MP4 video file.
+// For an embedded video player (e.g. YouTube) set MimeType to "text/html"
+// and provide InputMessageContent.
+func NewInlineQueryResultVideo(id, videoURL, thumbnailURL, title string) *InlineQueryResultVideo {
+	return &InlineQueryResultVideo{ID: id, VideoURL: videoURL, MimeType: "video/mp4", ThumbnailURL: thumbnailURL, Title: title}
+}
+
+// NewInlineQueryResultAudio creates an MP3 audio result.
+func NewInlineQueryResultAudio(id, audioURL, title string) *InlineQueryResultAudio {
+	return &InlineQueryResultAudio{ID: id, AudioURL: audioURL, Title: title}
+}
+
+// NewInlineQueryResultVoice creates a voice recording result.
+func NewInlineQueryResultVoice(id, voiceURL, title string) *InlineQueryResultVoice {
+	return &InlineQueryResultVoice{ID: id, VoiceURL: voiceURL, Title: title}
+}
+
+// NewInlineQueryResultDocument creates a document result.
+// mimeType is either "application/pdf" or "application/zip".
+func NewInlineQueryResultDocument(id, documentURL, mimeType, title string) *InlineQueryResultDocument {
+	return &InlineQueryResultDocument{ID: id, DocumentURL: documentURL, MimeType: mimeType, Title: title}
+}
+
+// NewInlineQueryResultLocation creates a location result.
+func NewInlineQueryResultLocation(id string, latitude, longitude float64, title string) *InlineQueryResultLocation {
+	return &InlineQueryResultLocation{ID: id, Latitude: latitude, Longitude: longitude, Title: title}
+}
+
+// NewInlineQueryResultVenue creates a venue result.
+func NewInlineQueryResultVenue(id string, latitude, longitude float64, title, address string) *InlineQueryResultVenue {
+	return &InlineQueryResultVenue{ID: id, Latitude: latitude, Longitude: longitude, Title: title, Address: address}
+}
+
+// NewInlineQueryResultContact creates a contact result.
+func NewInlineQueryResultContact(id, phoneNumber, firstName string) *InlineQueryResultContact {
+	return &InlineQueryResultContact{ID: id, PhoneNumber: phoneNumber, FirstName: firstName}
+}
+
+// NewInlineQueryResultGame creates a game result.
+func NewInlineQueryResultGame(id, gameShortName string) *InlineQueryResultGame {
+	return &InlineQueryResultGame{ID: id, GameShortName: gameShortName}
+}
+
+// NewInlineQueryResultCachedPhoto creates a result for a photo stored on the Telegram servers.
+func NewInlineQueryResultCachedPhoto(id, photoFileID string) *InlineQueryResultCachedPhoto {
+	return &InlineQueryResultCachedPhoto{ID: id, PhotoFileID: photoFileID}
+}
+
+// NewInlineQueryResultCachedGif creates a result for a GIF stored on the Telegram servers.
+func NewInlineQueryResultCachedGif(id, gifFileID string) *InlineQueryResultCachedGif {
+	return &InlineQueryResultCachedGif{ID: id, GifFileID: gifFileID}
+}
+
+// NewInlineQueryResultCachedMpeg4Gif creates a result for an MPEG-4 animation stored on the Telegram servers.
+func NewInlineQueryResultCachedMpeg4Gif(id, mpeg4FileID string) *InlineQueryResultCachedMpeg4Gif {
+	return &InlineQueryResultCachedMpeg4Gif{ID: id, Mpeg4FileID: mpeg4FileID}
+}
+
+// NewInlineQueryResultCachedSticker creates a result for a sticker stored on the Telegram servers.
+func NewInlineQueryResultCachedSticker(id, stickerFileID string) *InlineQueryResultCachedSticker {
+	return &InlineQueryResultCachedSticker{ID: id, StickerFileID: stickerFileID}
+}
+
+// NewInlineQueryResultCachedDocument creates a result for a file stored on the Telegram servers.
+func NewInlineQueryResultCachedDocument(id, documentFileID, title string) *InlineQueryResultCachedDocument {
+	return &InlineQueryResultCachedDocument{ID: id, DocumentFileID: documentFileID, Title: title}
+}
+
+// NewInlineQueryResultCachedVideo creates a result for a video stored on the Telegram servers.
+func NewInlineQueryResultCachedVideo(id, videoFileID, title string) *InlineQueryResultCachedVideo {
+	return &InlineQueryResultCachedVideo{ID: id, VideoFileID: videoFileID, Title: title}
+}
+
+// NewInlineQueryResultCachedVoice creates a result for a voice message stored on the Telegram servers.
+func NewInlineQueryResultCachedVoice(id, voiceFileID, title string) *InlineQueryResultCachedVoice {
+	return &InlineQueryResultCachedVoice{ID: id, VoiceFileID: voiceFileID, Title: title}
+}
+
+// NewInlineQueryResultCachedAudio creates a result for an audio file stored on the Telegram servers.
+func NewInlineQueryResultCachedAudio(id, audioFileID string) *InlineQueryResultCachedAudio {
+	return &InlineQueryResultCachedAudio{ID: id, AudioFileID: audioFileID}
+}
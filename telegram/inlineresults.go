@@ -0,0 +1,87 @@
+package telegram
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// The constructors below cover the common inline results with their required
+// fields only. An empty id is replaced by a hash of the result's content, so
+// that the same result keeps the same id across queries, as reported by
+// ChosenInlineResult.
+
+// resultID returns id, or an id derived from parts if id is empty.
+func resultID(id string, parts ...string) string {
+	if id != "" {
+		return id
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// ArticleResult creates an article sending text when chosen.
+func ArticleResult(id, title, text string) *InlineQueryResultArticle {
+	return NewInlineQueryResultArticle(resultID(id, "article", title, text), title, &InputTextMessageContent{MessageText: text})
+}
+
+// StyledArticleResult creates an article sending a formatted text when chosen.
+func StyledArticleResult(id, title string, text *StyledText) *InlineQueryResultArticle {
+	content := &InputTextMessageContent{MessageText: text.Text, Entities: text.Entities}
+	return NewInlineQueryResultArticle(resultID(id, "article", title, text.Text), title, content)
+}
+
+// PhotoResult creates a photo result with a thumbnail, which defaults to the
+// photo itself if empty.
+func PhotoResult(id, photoURL, thumbnailURL string) *InlineQueryResultPhoto {
+	result := NewInlineQueryResultPhoto(resultID(id, "photo", photoURL), photoURL)
+	if thumbnailURL != "" {
+		result.ThumbnailURL = thumbnailURL
+	}
+	return result
+}
+
+// GifResult creates an animated GIF result.
+func GifResult(id, gifURL string) *InlineQueryResultGif {
+	return NewInlineQueryResultGif(resultID(id, "gif", gifURL), gifURL)
+}
+
+// VideoResult creates an MP4 video result.
+func VideoResult(id, videoURL, thumbnailURL, title string) *InlineQueryResultVideo {
+	return NewInlineQueryResultVideo(resultID(id, "video", videoURL), videoURL, thumbnailURL, title)
+}
+
+// AudioResult creates an MP3 audio result.
+func AudioResult(id, audioURL, title string) *InlineQueryResultAudio {
+	return NewInlineQueryResultAudio(resultID(id, "audio", audioURL), audioURL, title)
+}
+
+// DocumentResult creates a PDF or ZIP document result, the MIME type being
+// guessed from the extension of the URL.
+func DocumentResult(id, documentURL, title string) *InlineQueryResultDocument {
+	mimeType := "application/pdf"
+	if strings.HasSuffix(strings.ToLower(documentURL), ".zip") {
+		mimeType = "application/zip"
+	}
+	return NewInlineQueryResultDocument(resultID(id, "document", documentURL), documentURL, mimeType, title)
+}
+
+// LocationResult creates a location result.
+func LocationResult(id string, latitude, longitude float64, title string) *InlineQueryResultLocation {
+	return NewInlineQueryResultLocation(resultID(id, "location", title), latitude, longitude, title)
+}
+
+// CachedPhotoResult creates a result for a photo stored on the Telegram servers.
+func CachedPhotoResult(id, fileID string) *InlineQueryResultCachedPhoto {
+	return NewInlineQueryResultCachedPhoto(resultID(id, "photo", fileID), fileID)
+}
+
+// CachedStickerResult creates a result for a sticker stored on the Telegram servers.
+func CachedStickerResult(id, fileID string) *InlineQueryResultCachedSticker {
+	return NewInlineQueryResultCachedSticker(resultID(id, "sticker", fileID), fileID)
+}
+
+// CachedDocumentResult creates a result for a file stored on the Telegram servers.
+func CachedDocumentResult(id, fileID, title string) *InlineQueryResultCachedDocument {
+	return NewInlineQueryResultCachedDocument(resultID(id, "document", fileID), fileID, title)
+}
@@ -0,0 +1,208 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// InputFile represents a file to be sent: a file_id already stored on the
+// Telegram servers, an HTTP URL for Telegram to fetch, or new content to
+// upload using multipart/form-data.
+// Requests containing an upload are switched to multipart automatically.
+// @docs https://core.telegram.org/bots/api#inputfile
+type InputFile struct {
+	FileID string
+	URL    string
+	Name   string
+	Reader io.Reader
+	Path   string
+	// Size of the upload in bytes, used for progress reporting; detected for FileBytes and FilePath.
+	Size int64
+	// Progress, if set, is called while the file is being uploaded.
+	Progress ProgressFunc
+	// attach is the multipart part name assigned while preparing a request
+	attach string
+	// field is the json name of the parameter holding the file
+	field string
+	// cached is the file_id found in the upload cache for this content, under cacheKey
+	cached   string
+	cacheKey string
+}
+
+// FileID references a file that already exists on the Telegram servers.
+func FileID(id string) *InputFile {
+	return &InputFile{FileID: id}
+}
+
+// FileURL lets Telegram download the file from an HTTP URL.
+func FileURL(url string) *InputFile {
+	return &InputFile{URL: url}
+}
+
+// FileReader uploads the content read from r under the given file name.
+func FileReader(name string, r io.Reader) *InputFile {
+	return &InputFile{Name: name, Reader: r}
+}
+
+// FileBytes uploads data under the given file name.
+func FileBytes(name string, data []byte) *InputFile {
+	return &InputFile{Name: name, Reader: bytes.NewReader(data), Size: int64(len(data))}
+}
+
+// FilePath uploads a local file. The file is opened when the request is sent.
+func FilePath(path string) *InputFile {
+	return &InputFile{Name: filepath.Base(path), Path: path}
+}
+
+// WithProgress sets a callback reporting the upload progress of the file.
+func (f *InputFile) WithProgress(progress ProgressFunc) *InputFile {
+	f.Progress = progress
+	return f
+}
+
+// IsUpload reports whether the file content has to be uploaded.
+func (f *InputFile) IsUpload() bool {
+	return f.cached == "" && f.hasContent()
+}
+
+func (f *InputFile) hasContent() bool {
+	return f.Reader != nil || f.Path != ""
+}
+
+// String returns the value sent for the file field:
+// "attach://<name>" for uploads, otherwise the file_id or URL.
+func (f *InputFile) String() string {
+	if f.IsUpload() {
+		return "attach://" + f.attach
+	}
+	if f.cached != "" {
+		return f.cached
+	}
+	if f.FileID != "" {
+		return f.FileID
+	}
+	return f.URL
+}
+
+func (f *InputFile) MarshalJSON() ([]byte, error) {
+	if f.IsUpload() && f.attach == "" {
+		return nil, fmt.Errorf("telegram: file %q must be sent as multipart upload", f.Name)
+	}
+	return json.Marshal(f.String())
+}
+
+// open returns the content of an upload and its file name.
+func (f *InputFile) open() (io.ReadCloser, error) {
+	if f.Reader != nil {
+		if rc, ok := f.Reader.(io.ReadCloser); ok {
+			return rc, nil
+		}
+		return io.NopCloser(f.Reader), nil
+	}
+	return os.Open(f.Path)
+}
+
+// size returns the size of an upload if known.
+func (f *InputFile) size() int64 {
+	if f.Size == 0 && f.Path != "" {
+		if info, err := os.Stat(f.Path); err == nil {
+			return info.Size()
+		}
+	}
+	return f.Size
+}
+
+// collectUploads walks params and returns every InputFile that needs to be
+// uploaded. The files are copies assigned a unique attach name, so params is
+// returned copied where it references them and the caller's files are left
+// untouched. A file read from an io.Reader can only be uploaded once.
+func collectUploads(params any) (prepared any, files []*InputFile, err error) {
+	seen := make(map[*InputFile]bool)
+	// walk returns a copy of v if it references uploads, otherwise v itself
+	var walk func(v reflect.Value, field string) (reflect.Value, bool)
+	walk = func(v reflect.Value, field string) (reflect.Value, bool) {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if v.IsNil() || err != nil {
+				return v, false
+			}
+			if f, ok := v.Interface().(*InputFile); ok {
+				if !f.hasContent() {
+					return v, false
+				}
+				if seen[f] && f.Reader != nil {
+					err = fmt.Errorf("telegram: file %q is used twice, its reader can only be uploaded once", f.Name)
+					return v, false
+				}
+				seen[f] = true
+				upload := *f
+				upload.attach = fmt.Sprintf("file%d", len(files))
+				upload.field = field
+				upload.cached = ""
+				files = append(files, &upload)
+				return reflect.ValueOf(&upload), true
+			}
+			elem, changed := walk(v.Elem(), field)
+			if !changed || v.Kind() == reflect.Interface {
+				return elem, changed
+			}
+			copied := reflect.New(elem.Type())
+			copied.Elem().Set(elem)
+			return copied, true
+		case reflect.Struct:
+			var copied reflect.Value
+			for i := 0; i < v.NumField(); i++ {
+				sf := v.Type().Field(i)
+				if !sf.IsExported() {
+					continue
+				}
+				name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+				if value, changed := walk(v.Field(i), name); changed {
+					if !copied.IsValid() {
+						copied = reflect.New(v.Type()).Elem()
+						copied.Set(v)
+					}
+					copied.Field(i).Set(value)
+				}
+			}
+			if copied.IsValid() {
+				return copied, true
+			}
+		case reflect.Slice, reflect.Array:
+			var copied reflect.Value
+			for i := 0; i < v.Len(); i++ {
+				if value, changed := walk(v.Index(i), field); changed {
+					if !copied.IsValid() {
+						if v.Kind() == reflect.Slice {
+							copied = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+						} else {
+							copied = reflect.New(v.Type()).Elem()
+						}
+						reflect.Copy(copied, v)
+					}
+					copied.Index(i).Set(value)
+				}
+			}
+			if copied.IsValid() {
+				return copied, true
+			}
+		}
+		return v, false
+	}
+	prepared = params
+	if params != nil {
+		if value, changed := walk(reflect.ValueOf(params), ""); changed {
+			prepared = value.Interface()
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return
+}
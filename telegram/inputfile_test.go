@@ -0,0 +1,84 @@
+package telegram
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCollectUploads(t *testing.T) {
+	req := &AudioRequest{
+		ChatID:    1,
+		Audio:     FileBytes("song.mp3", []byte("data")),
+		Thumbnail: FileID("thumb-id"),
+	}
+	prepared, files, err := collectUploads(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Reader != req.Audio.Reader {
+		t.Fatalf("expected only the audio upload, got %v", files)
+	}
+	if req.Audio.attach != "" {
+		t.Errorf("the request was modified")
+	}
+	form := ToFormValues(prepared)
+	if form["audio"] != "attach://file0" {
+		t.Errorf("audio: got %q", form["audio"])
+	}
+	if form["thumbnail"] != "thumb-id" {
+		t.Errorf("thumbnail: got %q", form["thumbnail"])
+	}
+}
+
+func TestInputFileMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(&PhotoRequest{ChatID: 1, Photo: FileURL("https://example.com/a.png")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out map[string]any
+	json.Unmarshal(data, &out)
+	if out["photo"] != "https://example.com/a.png" {
+		t.Errorf("photo: got %v", out["photo"])
+	}
+	if _, err := json.Marshal(FilePath("/tmp/a.png")); err == nil {
+		t.Error("expected an error marshalling an upload without attach name")
+	}
+}
+
+func TestInputMediaUploads(t *testing.T) {
+	media := []InputMedia{
+		NewInputMediaPhoto(FileID("photo-id"), "first"),
+		NewInputMediaVideo(FileBytes("clip.mp4", []byte("data")), ""),
+	}
+	prepared, files, err := collectUploads(&struct {
+		Media []InputMedia `json:"media"`
+	}{media})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 upload, got %d", len(files))
+	}
+	data, err := json.Marshal(prepared)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"media":[{"type":"photo","media":"photo-id","caption":"first"},{"type":"video","media":"attach://file0","supports_streaming":true}]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+}
+
+func TestCollectUploadsReaderReused(t *testing.T) {
+	file := FileReader("a.png", strings.NewReader("data"))
+	_, _, err := collectUploads(&AudioRequest{ChatID: 1, Audio: file, Thumbnail: file})
+	if err == nil {
+		t.Error("expected an error uploading a reader twice")
+	}
+	path := FilePath("/tmp/a.png")
+	_, files, err := collectUploads(&AudioRequest{ChatID: 1, Audio: path, Thumbnail: path})
+	if err != nil || len(files) != 2 {
+		t.Errorf("got %d files, %v", len(files), err)
+	}
+}
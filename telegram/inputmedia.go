@@ -0,0 +1,135 @@
+package telegram
+
+// InputMedia represents the content of a media message to be sent.
+// It is one of InputMediaAnimation, InputMediaDocument, InputMediaAudio,
+// InputMediaPhoto or InputMediaVideo.
+// Uploaded files are sent using the attach:// scheme automatically.
+// @docs https://core.telegram.org/bots/api#inputmedia
+type InputMedia interface {
+	MediaType() string
+}
+
+// InputMediaPhoto represents a photo to be sent.
+// @docs https://core.telegram.org/bots/api#inputmediaphoto
+type InputMediaPhoto struct {
+	Media                 *InputFile       `json:"media"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+}
+
+// InputMediaVideo represents a video to be sent.
+// @docs https://core.telegram.org/bots/api#inputmediavideo
+type InputMediaVideo struct {
+	Media                 *InputFile       `json:"media"`
+	Thumbnail             *InputFile       `json:"thumbnail,omitempty"`
+	Cover                 *InputFile       `json:"cover,omitempty"`
+	StartTimestamp        int              `json:"start_timestamp,omitempty"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	Width                 int              `json:"width,omitempty"`
+	Height                int              `json:"height,omitempty"`
+	Duration              int              `json:"duration,omitempty"`
+	SupportsStreaming     bool             `json:"supports_streaming,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+}
+
+// InputMediaAnimation represents an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent.
+// @docs https://core.telegram.org/bots/api#inputmediaanimation
+type InputMediaAnimation struct {
+	Media                 *InputFile       `json:"media"`
+	Thumbnail             *InputFile       `json:"thumbnail,omitempty"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	Width                 int              `json:"width,omitempty"`
+	Height                int              `json:"height,omitempty"`
+	Duration              int              `json:"duration,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+}
+
+// InputMediaAudio represents an audio file to be treated as music to be sent.
+// @docs https://core.telegram.org/bots/api#inputmediaaudio
+type InputMediaAudio struct {
+	Media           *InputFile       `json:"media"`
+	Thumbnail       *InputFile       `json:"thumbnail,omitempty"`
+	Caption         string           `json:"caption,omitempty"`
+	ParseMode       ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities []*MessageEntity `json:"caption_entities,omitempty"`
+	Duration        int              `json:"duration,omitempty"`
+	Performer       string           `json:"performer,omitempty"`
+	Title           string           `json:"title,omitempty"`
+}
+
+// InputMediaDocument represents a general file to be sent.
+// @docs https://core.telegram.org/bots/api#inputmediadocument
+type InputMediaDocument struct {
+	Media                       *InputFile       `json:"media"`
+	Thumbnail                   *InputFile       `json:"thumbnail,omitempty"`
+	Caption                     string           `json:"caption,omitempty"`
+	ParseMode                   ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities             []*MessageEntity `json:"caption_entities,omitempty"`
+	DisableContentTypeDetection bool             `json:"disable_content_type_detection,omitempty"`
+}
+
+func (m *InputMediaPhoto) MediaType() string     { return "photo" }
+func (m *InputMediaVideo) MediaType() string     { return "video" }
+func (m *InputMediaAnimation) MediaType() string { return "animation" }
+func (m *InputMediaAudio) MediaType() string     { return "audio" }
+func (m *InputMediaDocument) MediaType() string  { return "document" }
+
+func (m *InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputMediaPhoto
+	return marshalUnion(m.MediaType(), (*alias)(m))
+}
+
+func (m *InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias InputMediaVideo
+	return marshalUnion(m.MediaType(), (*alias)(m))
+}
+
+func (m *InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAnimation
+	return marshalUnion(m.MediaType(), (*alias)(m))
+}
+
+func (m *InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAudio
+	return marshalUnion(m.MediaType(), (*alias)(m))
+}
+
+func (m *InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type alias InputMediaDocument
+	return marshalUnion(m.MediaType(), (*alias)(m))
+}
+
+// NewInputMediaPhoto creates a photo with an optional caption.
+func NewInputMediaPhoto(media *InputFile, caption string) *InputMediaPhoto {
+	return &InputMediaPhoto{Media: media, Caption: caption}
+}
+
+// NewInputMediaVideo creates a video with an optional caption.
+// Uploaded videos are marked as suitable for streaming.
+func NewInputMediaVideo(media *InputFile, caption string) *InputMediaVideo {
+	return &InputMediaVideo{Media: media, Caption: caption, SupportsStreaming: true}
+}
+
+// NewInputMediaAnimation creates an animation with an optional caption.
+func NewInputMediaAnimation(media *InputFile, caption string) *InputMediaAnimation {
+	return &InputMediaAnimation{Media: media, Caption: caption}
+}
+
+// NewInputMediaAudio creates an audio file with an optional caption.
+func NewInputMediaAudio(media *InputFile, caption string) *InputMediaAudio {
+	return &InputMediaAudio{Media: media, Caption: caption}
+}
+
+// NewInputMediaDocument creates a document with an optional caption.
+func NewInputMediaDocument(media *InputFile, caption string) *InputMediaDocument {
+	return &InputMediaDocument{Media: media, Caption: caption}
+}
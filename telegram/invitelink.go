@@ -0,0 +1,86 @@
+package telegram
+
+// ChatInviteLink represents an invite link for a chat.
+// @docs https://core.telegram.org/bots/api#chatinvitelink
+type ChatInviteLink struct {
+	InviteLink              string `json:"invite_link"` // Ends with "..." if the link was created by another administrator
+	Creator                 *User  `json:"creator"`
+	CreatesJoinRequest      bool   `json:"creates_join_request"`
+	IsPrimary               bool   `json:"is_primary"`
+	IsRevoked               bool   `json:"is_revoked"`
+	Name                    string `json:"name,omitempty"`
+	ExpireDate              int64  `json:"expire_date,omitempty"`
+	MemberLimit             int    `json:"member_limit,omitempty"`
+	PendingJoinRequestCount int    `json:"pending_join_request_count,omitempty"`
+	SubscriptionPeriod      int    `json:"subscription_period,omitempty"` // Seconds the subscription is active for before the next payment
+	SubscriptionPrice       int    `json:"subscription_price,omitempty"`  // Telegram Stars to pay for each subscription period
+}
+
+// ExportChatInviteLink generates a new primary invite link for a chat, revoking the previous one.
+// https://core.telegram.org/bots/api#exportchatinvitelink
+func (bot *TelegramBot) ExportChatInviteLink(chatID any) (link string, err error) {
+	err = bot.CallMethod("exportChatInviteLink", &ChatRequest{ChatID: chatID}, &link)
+	return
+}
+
+type ChatInviteLinkRequest struct {
+	ChatID     any    `json:"chat_id"`
+	InviteLink string `json:"invite_link,omitempty"` // The link to edit, for EditChatInviteLink only
+	Name       string `json:"name,omitempty"`        // 0-32 characters
+	ExpireDate int64  `json:"expire_date,omitempty"` // Unix time when the link will expire, see UntilDate
+	// Maximum number of users that can be members of the chat simultaneously after joining via the link; 1-99999
+	MemberLimit int `json:"member_limit,omitempty"`
+	// Users joining via the link need to be approved by administrators, MemberLimit can't be set
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+// CreateChatInviteLink creates an additional invite link for a chat.
+// https://core.telegram.org/bots/api#createchatinvitelink
+func (bot *TelegramBot) CreateChatInviteLink(req *ChatInviteLinkRequest) (link *ChatInviteLink, err error) {
+	err = bot.CallMethod("createChatInviteLink", req, &link)
+	return
+}
+
+// EditChatInviteLink edits a non-primary invite link created by the bot.
+// https://core.telegram.org/bots/api#editchatinvitelink
+func (bot *TelegramBot) EditChatInviteLink(req *ChatInviteLinkRequest) (link *ChatInviteLink, err error) {
+	err = bot.CallMethod("editChatInviteLink", req, &link)
+	return
+}
+
+type RevokeChatInviteLinkRequest struct {
+	ChatID     any    `json:"chat_id"`
+	InviteLink string `json:"invite_link"`
+}
+
+// RevokeChatInviteLink revokes an invite link created by the bot.
+// If the primary link is revoked, a new link is automatically generated.
+// https://core.telegram.org/bots/api#revokechatinvitelink
+func (bot *TelegramBot) RevokeChatInviteLink(chatID any, inviteLink string) (link *ChatInviteLink, err error) {
+	err = bot.CallMethod("revokeChatInviteLink", &RevokeChatInviteLinkRequest{ChatID: chatID, InviteLink: inviteLink}, &link)
+	return
+}
+
+type ChatSubscriptionInviteLinkRequest struct {
+	ChatID     any    `json:"chat_id"`
+	InviteLink string `json:"invite_link,omitempty"` // The link to edit, for EditChatSubscriptionInviteLink only
+	Name       string `json:"name,omitempty"`
+	// Seconds the subscription will be active for before the next payment, currently always 2592000 (30 days).
+	// For CreateChatSubscriptionInviteLink only, as the price.
+	SubscriptionPeriod int `json:"subscription_period,omitempty"`
+	SubscriptionPrice  int `json:"subscription_price,omitempty"` // 1-10000 Telegram Stars
+}
+
+// CreateChatSubscriptionInviteLink creates a subscription invite link for a channel chat.
+// https://core.telegram.org/bots/api#createchatsubscriptioninvitelink
+func (bot *TelegramBot) CreateChatSubscriptionInviteLink(req *ChatSubscriptionInviteLinkRequest) (link *ChatInviteLink, err error) {
+	err = bot.CallMethod("createChatSubscriptionInviteLink", req, &link)
+	return
+}
+
+// EditChatSubscriptionInviteLink edits the name of a subscription invite link created by the bot.
+// https://core.telegram.org/bots/api#editchatsubscriptioninvitelink
+func (bot *TelegramBot) EditChatSubscriptionInviteLink(req *ChatSubscriptionInviteLinkRequest) (link *ChatInviteLink, err error) {
+	err = bot.CallMethod("editChatSubscriptionInviteLink", req, &link)
+	return
+}
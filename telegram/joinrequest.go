@@ -0,0 +1,44 @@
+package telegram
+
+// ChatJoinRequest represents a join request sent to a chat.
+// @docs https://core.telegram.org/bots/api#chatjoinrequest
+type ChatJoinRequest struct {
+	Chat *Chat `json:"chat"`
+	From *User `json:"from"`
+	// Identifier of a private chat with the user who sent the join request.
+	// The bot can use it to send messages until the join request is processed.
+	UserChatID int64           `json:"user_chat_id"`
+	Date       int64           `json:"date"`
+	Bio        string          `json:"bio,omitempty"`
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+// ApproveChatJoinRequest approves a chat join request.
+// https://core.telegram.org/bots/api#approvechatjoinrequest
+func (bot *TelegramBot) ApproveChatJoinRequest(chatID any, userID int64) error {
+	return bot.CallMethod("approveChatJoinRequest", &ChatMemberRequest{ChatID: chatID, UserID: userID}, nil)
+}
+
+// DeclineChatJoinRequest declines a chat join request.
+// https://core.telegram.org/bots/api#declinechatjoinrequest
+func (bot *TelegramBot) DeclineChatJoinRequest(chatID any, userID int64) error {
+	return bot.CallMethod("declineChatJoinRequest", &ChatMemberRequest{ChatID: chatID, UserID: userID}, nil)
+}
+
+// ApproveJoinRequest approves req.
+func (bot *TelegramBot) ApproveJoinRequest(req *ChatJoinRequest) error {
+	return bot.ApproveChatJoinRequest(req.Chat.ID, req.From.ID)
+}
+
+// DeclineJoinRequest declines req.
+func (bot *TelegramBot) DeclineJoinRequest(req *ChatJoinRequest) error {
+	return bot.DeclineChatJoinRequest(req.Chat.ID, req.From.ID)
+}
+
+// MessageApplicant sends a message to the user who sent req, e.g. to ask questions
+// before approving it. The message must be sent before the request is processed.
+func (bot *TelegramBot) MessageApplicant(req *ChatJoinRequest, message *MessageRequest) (*Message, error) {
+	m := *message
+	m.ChatID = req.UserChatID
+	return bot.SendMessage(&m)
+}
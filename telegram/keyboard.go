@@ -0,0 +1,175 @@
+package telegram
+
+// ReplyMarkup is one of InlineKeyboardMarkup, ReplyKeyboardMarkup,
+// ReplyKeyboardRemove or ForceReply, accepted by the reply_markup
+// parameter of the send methods.
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+// InlineKeyboardMarkup represents an inline keyboard that appears right next to the message it belongs to.
+// @docs https://core.telegram.org/bots/api#inlinekeyboardmarkup
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]*InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// InlineKeyboardButton represents one button of an inline keyboard.
+// Exactly one of the optional fields must be used to specify type of the button.
+// @docs https://core.telegram.org/bots/api#inlinekeyboardbutton
+type InlineKeyboardButton struct {
+	Text         string      `json:"text"`
+	URL          string      `json:"url,omitempty"`
+	CallbackData string      `json:"callback_data,omitempty"` // 1-64 bytes
+	WebApp       *WebAppInfo `json:"web_app,omitempty"`
+	// Prompts the user to select one of their chats and inserts the bot's username and the query in the input field
+	SwitchInlineQuery *string `json:"switch_inline_query,omitempty"`
+	// Inserts the bot's username and the query in the input field of the current chat
+	SwitchInlineQueryCurrentChat *string `json:"switch_inline_query_current_chat,omitempty"`
+	// Prompts the user to select one of their chats of the specified type and inserts the bot's username and the query in the input field
+	SwitchInlineQueryChosenChat *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
+	// HTTPS URL used to automatically authorize the user, replacing the Telegram Login Widget
+	LoginURL *LoginURL `json:"login_url,omitempty"`
+	// Copies the text to the clipboard
+	CopyText *CopyTextButton `json:"copy_text,omitempty"`
+	// Launches the game of the message. Must always be the first button in the first row.
+	CallbackGame *CallbackGame `json:"callback_game,omitempty"`
+	// Pay button. Must always be the first button in the first row and can only be used in invoice messages.
+	Pay bool `json:"pay,omitempty"`
+}
+
+// CopyTextButton represents an inline keyboard button that copies specified text to the clipboard.
+// @docs https://core.telegram.org/bots/api#copytextbutton
+type CopyTextButton struct {
+	Text string `json:"text"` // 1-256 characters
+}
+
+// LoginURL represents a parameter of the inline keyboard button used to automatically authorize a user.
+// The data added to the URL is the same as the one of the Telegram Login Widget.
+// @docs https://core.telegram.org/bots/api#loginurl
+type LoginURL struct {
+	URL                string `json:"url"`
+	ForwardText        string `json:"forward_text,omitempty"` // New text of the button in forwarded messages
+	BotUsername        string `json:"bot_username,omitempty"` // Bot used for the authorization, defaults to the current bot
+	RequestWriteAccess bool   `json:"request_write_access,omitempty"`
+}
+
+// SwitchInlineQueryChosenChat represents an inline button that switches the current user
+// to inline mode in a chosen chat, with an optional default inline query.
+// @docs https://core.telegram.org/bots/api#switchinlinequerychosenchat
+type SwitchInlineQueryChosenChat struct {
+	Query             string `json:"query,omitempty"`
+	AllowUserChats    bool   `json:"allow_user_chats,omitempty"`
+	AllowBotChats     bool   `json:"allow_bot_chats,omitempty"`
+	AllowGroupChats   bool   `json:"allow_group_chats,omitempty"`
+	AllowChannelChats bool   `json:"allow_channel_chats,omitempty"`
+}
+
+// ReplyKeyboardMarkup represents a custom keyboard with reply options.
+// @docs https://core.telegram.org/bots/api#replykeyboardmarkup
+type ReplyKeyboardMarkup struct {
+	Keyboard              [][]*KeyboardButton `json:"keyboard"`
+	IsPersistent          bool                `json:"is_persistent,omitempty"`
+	ResizeKeyboard        bool                `json:"resize_keyboard,omitempty"`
+	OneTimeKeyboard       bool                `json:"one_time_keyboard,omitempty"`
+	InputFieldPlaceholder string              `json:"input_field_placeholder,omitempty"`
+	Selective             bool                `json:"selective,omitempty"`
+}
+
+// KeyboardButton represents one button of the reply keyboard.
+// @docs https://core.telegram.org/bots/api#keyboardbutton
+type KeyboardButton struct {
+	Text            string                      `json:"text"`
+	RequestUsers    *KeyboardButtonRequestUsers `json:"request_users,omitempty"`
+	RequestChat     *KeyboardButtonRequestChat  `json:"request_chat,omitempty"`
+	RequestContact  bool                        `json:"request_contact,omitempty"`
+	RequestLocation bool                        `json:"request_location,omitempty"`
+	RequestPoll     *KeyboardButtonPollType     `json:"request_poll,omitempty"`
+	WebApp          *WebAppInfo                 `json:"web_app,omitempty"`
+}
+
+// KeyboardButtonRequestUsers defines the criteria used to request suitable users.
+// Information about the selected users will be shared with the bot when the corresponding button is pressed.
+// @docs https://core.telegram.org/bots/api#keyboardbuttonrequestusers
+type KeyboardButtonRequestUsers struct {
+	RequestID       int   `json:"request_id"`
+	UserIsBot       *bool `json:"user_is_bot,omitempty"`
+	UserIsPremium   *bool `json:"user_is_premium,omitempty"`
+	MaxQuantity     int   `json:"max_quantity,omitempty"` // 1-10, defaults to 1
+	RequestName     bool  `json:"request_name,omitempty"`
+	RequestUsername bool  `json:"request_username,omitempty"`
+	RequestPhoto    bool  `json:"request_photo,omitempty"`
+}
+
+// KeyboardButtonRequestChat defines the criteria used to request a suitable chat.
+// The bot will be granted requested rights in the chat if appropriate.
+// @docs https://core.telegram.org/bots/api#keyboardbuttonrequestchat
+type KeyboardButtonRequestChat struct {
+	RequestID               int                      `json:"request_id"`
+	ChatIsChannel           bool                     `json:"chat_is_channel"`
+	ChatIsForum             *bool                    `json:"chat_is_forum,omitempty"`
+	ChatHasUsername         *bool                    `json:"chat_has_username,omitempty"`
+	ChatIsCreated           bool                     `json:"chat_is_created,omitempty"`
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	BotAdministratorRights  *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	BotIsMember             bool                     `json:"bot_is_member,omitempty"`
+	RequestTitle            bool                     `json:"request_title,omitempty"`
+	RequestUsername         bool                     `json:"request_username,omitempty"`
+	RequestPhoto            bool                     `json:"request_photo,omitempty"`
+}
+
+// KeyboardButtonPollType represents type of a poll, which is allowed to be created and sent when the corresponding button is pressed.
+// @docs https://core.telegram.org/bots/api#keyboardbuttonpolltype
+type KeyboardButtonPollType struct {
+	// If "quiz" is passed, the user will be allowed to create only polls in the quiz mode.
+	// If "regular" is passed, only regular polls will be allowed. Otherwise, the user will be allowed to create a poll of any type.
+	Type string `json:"type,omitempty"`
+}
+
+// UsersShared contains information about the users whose identifiers were shared with the bot
+// using a KeyboardButtonRequestUsers button.
+// @docs https://core.telegram.org/bots/api#usersshared
+type UsersShared struct {
+	RequestID int           `json:"request_id"`
+	Users     []*SharedUser `json:"users"`
+}
+
+// SharedUser contains information about a user that was shared with the bot using a KeyboardButtonRequestUsers button.
+// @docs https://core.telegram.org/bots/api#shareduser
+type SharedUser struct {
+	UserID    int64        `json:"user_id"`
+	FirstName string       `json:"first_name,omitempty"`
+	LastName  string       `json:"last_name,omitempty"`
+	Username  string       `json:"username,omitempty"`
+	Photo     []*PhotoSize `json:"photo,omitempty"`
+}
+
+// ChatShared contains information about a chat that was shared with the bot using a KeyboardButtonRequestChat button.
+// @docs https://core.telegram.org/bots/api#chatshared
+type ChatShared struct {
+	RequestID int          `json:"request_id"`
+	ChatID    int64        `json:"chat_id"`
+	Title     string       `json:"title,omitempty"`
+	Username  string       `json:"username,omitempty"`
+	Photo     []*PhotoSize `json:"photo,omitempty"`
+}
+
+// ReplyKeyboardRemove tells Telegram clients to remove the current custom keyboard.
+// @docs https://core.telegram.org/bots/api#replykeyboardremove
+type ReplyKeyboardRemove struct {
+	RemoveKeyboard bool `json:"remove_keyboard"` // Must be true
+	Selective      bool `json:"selective,omitempty"`
+}
+
+// ForceReply tells Telegram clients to display a reply interface to the user,
+// as if the user has selected the bot's message and tapped 'Reply'.
+// @docs https://core.telegram.org/bots/api#forcereply
+type ForceReply struct {
+	ForceReply            bool   `json:"force_reply"` // Must be true
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+	Selective             bool   `json:"selective,omitempty"`
+}
+
+func (*InlineKeyboardMarkup) replyMarkup() {}
+func (*ReplyKeyboardMarkup) replyMarkup()  {}
+func (*ReplyKeyboardRemove) replyMarkup()  {}
+func (*ForceReply) replyMarkup()           {}
@@ -0,0 +1,25 @@
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInlineKeyboardGrid(t *testing.T) {
+	var buttons []*InlineKeyboardButton
+	for _, n := range []string{"1", "2", "3", "4", "5"} {
+		buttons = append(buttons, CallbackButton(n, n))
+	}
+	kb := NewInlineKeyboard().Grid(2, buttons...).Row(SwitchInlineButton("Share", ""))
+	var sizes []int
+	for _, row := range kb.InlineKeyboard {
+		sizes = append(sizes, len(row))
+	}
+	if len(sizes) != 4 || sizes[0] != 2 || sizes[2] != 1 || sizes[3] != 1 {
+		t.Errorf("rows: got %v", sizes)
+	}
+	data, _ := json.Marshal(kb.InlineKeyboard[3][0])
+	if string(data) != `{"text":"Share","switch_inline_query":""}` {
+		t.Errorf("got %s", data)
+	}
+}
@@ -0,0 +1,188 @@
+package telegram
+
+// NewInlineKeyboard returns an empty inline keyboard, to be filled with Row and Grid:
+//
+//	kb := telegram.NewInlineKeyboard().
+//		Row(telegram.CallbackButton("Yes", "yes"), telegram.CallbackButton("No", "no")).
+//		Row(telegram.URLButton("Docs", "https://example.com"))
+func NewInlineKeyboard() *InlineKeyboardMarkup {
+	return &InlineKeyboardMarkup{InlineKeyboard: [][]*InlineKeyboardButton{}}
+}
+
+// Row adds a row of buttons to the keyboard.
+func (kb *InlineKeyboardMarkup) Row(buttons ...*InlineKeyboardButton) *InlineKeyboardMarkup {
+	if len(buttons) > 0 {
+		kb.InlineKeyboard = append(kb.InlineKeyboard, buttons)
+	}
+	return kb
+}
+
+// Grid adds buttons to the keyboard in rows of the given number of columns,
+// the last row holding the remaining buttons.
+func (kb *InlineKeyboardMarkup) Grid(columns int, buttons ...*InlineKeyboardButton) *InlineKeyboardMarkup {
+	for _, row := range chunkButtons(buttons, columns) {
+		kb.Row(row...)
+	}
+	return kb
+}
+
+// chunkButtons splits buttons into rows of size buttons.
+func chunkButtons[T any](buttons []T, size int) (rows [][]T) {
+	if size <= 0 {
+		size = 1
+	}
+	for len(buttons) > size {
+		rows = append(rows, buttons[:size:size])
+		buttons = buttons[size:]
+	}
+	if len(buttons) > 0 {
+		rows = append(rows, buttons)
+	}
+	return
+}
+
+// CallbackButton returns a button sending a callback query with data (1-64 bytes) when pressed.
+func CallbackButton(text, data string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, CallbackData: data}
+}
+
+// URLButton returns a button opening url, which can also be a tg:// link.
+func URLButton(text, url string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, URL: url}
+}
+
+// WebAppButton returns a button launching the Web App at url, in private chats only.
+func WebAppButton(text, url string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// PayButton returns the pay button of an invoice, which must be the first button of the first row.
+func PayButton(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, Pay: true}
+}
+
+// PlayButton returns the button launching the game of a game message,
+// which must be the first button of the first row.
+func PlayButton(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, CallbackGame: &CallbackGame{}}
+}
+
+// SwitchInlineButton returns a button prompting the user to select a chat
+// and opening the bot's inline mode there with query.
+func SwitchInlineButton(text, query string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, SwitchInlineQuery: &query}
+}
+
+// SwitchInlineCurrentChatButton returns a button opening the bot's inline mode with query in the current chat.
+func SwitchInlineCurrentChatButton(text, query string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, SwitchInlineQueryCurrentChat: &query}
+}
+
+// SwitchInlineChosenChatButton returns a button prompting the user to select a chat
+// allowed by chat and opening the bot's inline mode there.
+func SwitchInlineChosenChatButton(text string, chat *SwitchInlineQueryChosenChat) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, SwitchInlineQueryChosenChat: chat}
+}
+
+// LoginButton returns a button authorizing the user on the website at url.
+// The domain must be linked to the bot with the /setdomain command of @BotFather.
+func LoginButton(text, url string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, LoginURL: &LoginURL{URL: url}}
+}
+
+// CopyButton returns a button copying text (1-256 characters) to the clipboard.
+func CopyButton(text, copyText string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{Text: text, CopyText: &CopyTextButton{Text: copyText}}
+}
+
+// NewReplyKeyboard returns an empty custom keyboard, to be filled with Row and Grid:
+//
+//	kb := telegram.NewReplyKeyboard().
+//		Row(telegram.TextButton("Menu"), telegram.LocationButton("Share location")).
+//		Resize().OneTime()
+func NewReplyKeyboard() *ReplyKeyboardMarkup {
+	return &ReplyKeyboardMarkup{Keyboard: [][]*KeyboardButton{}}
+}
+
+// Row adds a row of buttons to the keyboard.
+func (kb *ReplyKeyboardMarkup) Row(buttons ...*KeyboardButton) *ReplyKeyboardMarkup {
+	if len(buttons) > 0 {
+		kb.Keyboard = append(kb.Keyboard, buttons)
+	}
+	return kb
+}
+
+// Grid adds buttons to the keyboard in rows of the given number of columns.
+func (kb *ReplyKeyboardMarkup) Grid(columns int, buttons ...*KeyboardButton) *ReplyKeyboardMarkup {
+	for _, row := range chunkButtons(buttons, columns) {
+		kb.Row(row...)
+	}
+	return kb
+}
+
+// Resize asks clients to fit the keyboard to its buttons.
+func (kb *ReplyKeyboardMarkup) Resize() *ReplyKeyboardMarkup {
+	kb.ResizeKeyboard = true
+	return kb
+}
+
+// OneTime asks clients to hide the keyboard once it's been used.
+func (kb *ReplyKeyboardMarkup) OneTime() *ReplyKeyboardMarkup {
+	kb.OneTimeKeyboard = true
+	return kb
+}
+
+// Persistent always shows the keyboard when the regular keyboard is hidden.
+func (kb *ReplyKeyboardMarkup) Persistent() *ReplyKeyboardMarkup {
+	kb.IsPersistent = true
+	return kb
+}
+
+// Placeholder sets the placeholder shown in the input field when the keyboard is active, 1-64 characters.
+func (kb *ReplyKeyboardMarkup) Placeholder(text string) *ReplyKeyboardMarkup {
+	kb.InputFieldPlaceholder = text
+	return kb
+}
+
+// Selectively shows the keyboard only to the users mentioned in the text of
+// the message and the sender of the message it replies to.
+func (kb *ReplyKeyboardMarkup) Selectively() *ReplyKeyboardMarkup {
+	kb.Selective = true
+	return kb
+}
+
+// RemoveKeyboard returns the markup removing the current custom keyboard.
+func RemoveKeyboard() *ReplyKeyboardRemove {
+	return &ReplyKeyboardRemove{RemoveKeyboard: true}
+}
+
+// TextButton returns a button sending its text when pressed.
+func TextButton(text string) *KeyboardButton {
+	return &KeyboardButton{Text: text}
+}
+
+// ContactButton returns a button sending the user's phone number, in private chats only.
+func ContactButton(text string) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestContact: true}
+}
+
+// LocationButton returns a button sending the user's current location, in private chats only.
+func LocationButton(text string) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestLocation: true}
+}
+
+// PollButton returns a button asking the user to create a poll of pollType
+// ("quiz", "regular" or "" for any), in private chats only.
+func PollButton(text string, pollType PollType) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestPoll: &KeyboardButtonPollType{Type: string(pollType)}}
+}
+
+// RequestUsersButton returns a button asking the user to select users matching req, in private chats only.
+func RequestUsersButton(text string, req *KeyboardButtonRequestUsers) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestUsers: req}
+}
+
+// RequestChatButton returns a button asking the user to select a chat matching req, in private chats only.
+func RequestChatButton(text string, req *KeyboardButtonRequestChat) *KeyboardButton {
+	return &KeyboardButton{Text: text, RequestChat: req}
+}
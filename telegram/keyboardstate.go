@@ -0,0 +1,107 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// KeyboardState is what a Router remembers about an inline keyboard sent by
+// the bot: the menu handling its buttons and the state of that menu, which
+// unlike callback data isn't limited to MaxCallbackDataLength bytes.
+type KeyboardState struct {
+	Menu    string          `json:"menu"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func keyboardKey(chatID, messageID int64) string {
+	return fmt.Sprintf("keyboard:%d:%d", chatID, messageID)
+}
+
+func inlineKeyboardKey(inlineMessageID string) string {
+	return "keyboard:inline:" + inlineMessageID
+}
+
+// SaveKeyboard remembers that the keyboard of message belongs to menu, with
+// payload as its state. Presses on its buttons are handled by the OnMenu
+// handler of menu, even after a restart if Store is persistent.
+func (r *Router) SaveKeyboard(message *Message, menu string, payload any) error {
+	return r.saveKeyboard(keyboardKey(message.Chat.ID, message.MessageID), menu, payload)
+}
+
+// SaveInlineKeyboard is like SaveKeyboard for a message sent via inline mode.
+func (r *Router) SaveInlineKeyboard(inlineMessageID, menu string, payload any) error {
+	return r.saveKeyboard(inlineKeyboardKey(inlineMessageID), menu, payload)
+}
+
+func (r *Router) saveKeyboard(key, menu string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(&KeyboardState{Menu: menu, Payload: data})
+	if err != nil {
+		return err
+	}
+	return r.Store.Set(key, value)
+}
+
+// ForgetKeyboard drops the state of the keyboard of a message, e.g. once
+// the message is deleted or its keyboard removed.
+func (r *Router) ForgetKeyboard(chatID, messageID int64) error {
+	return r.Store.Delete(keyboardKey(chatID, messageID))
+}
+
+// Keyboard returns the saved state of the keyboard of the message of a
+// callback query, nil if there is none.
+func (r *Router) Keyboard(query *CallbackQuery) (state *KeyboardState, err error) {
+	key := inlineKeyboardKey(query.InlineMessageID)
+	if query.InlineMessageID == "" {
+		if query.Message == nil || query.Message.Chat == nil {
+			return nil, nil
+		}
+		key = keyboardKey(query.Message.Chat.ID, query.Message.MessageID)
+	}
+	value, ok, err := r.Store.Get(key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	err = json.Unmarshal(value, &state)
+	return
+}
+
+// OnMenu handles the callback queries of the keyboards saved for menu with
+// SaveKeyboard, calling fn with the saved state. The pressed button is
+// identified by query.Data. Register OnMenu handlers before OnCallback ones
+// using the same callback data.
+//
+//	msg, _ := bot.SendMessage(&telegram.MessageRequest{ChatID: chatID, Text: "Cart", ReplyMarkup: keyboard})
+//	router.SaveKeyboard(msg, "cart", cart)
+//	telegram.OnMenu(router, "cart", func(q *telegram.CallbackQuery, msg *telegram.Message, cart *Cart) error {
+//		...
+//	})
+func OnMenu[T any](r *Router, menu string, fn func(query *CallbackQuery, message *Message, state T) error) {
+	r.handle(func(update *Update) (bool, error) {
+		query := update.CallbackQuery
+		if query == nil {
+			return false, nil
+		}
+		saved, err := r.Keyboard(query)
+		if err != nil {
+			return true, err
+		}
+		if saved == nil || saved.Menu != menu {
+			return false, nil
+		}
+		var state T
+		if len(saved.Payload) > 0 {
+			if err := json.Unmarshal(saved.Payload, &state); err != nil {
+				return true, fmt.Errorf("telegram: menu %q: %w", menu, err)
+			}
+		}
+		message := query.Message
+		if message != nil && !message.IsAccessible() {
+			message = nil
+		}
+		return true, fn(query, message, state)
+	})
+}
@@ -0,0 +1,123 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultLiveLocationInterval is the Interval of a LiveLocationUpdater which has none.
+const DefaultLiveLocationInterval = 10 * time.Second
+
+// LiveLocationUpdater keeps a live location message in sync with a stream of coordinates.
+//
+//	msg, _ := bot.SendLocation(&telegram.SendLocationRequest{ChatID: chatID, Latitude: lat, Longitude: lon, LivePeriod: 3600})
+//	updater := bot.NewLiveLocationUpdater(msg, 10*time.Second)
+//	go updater.Run(ctx, positions)
+type LiveLocationUpdater struct {
+	bot             *TelegramBot
+	ChatID          any
+	MessageID       int64
+	InlineMessageID string
+	// Interval is the minimum time between two edits of the message,
+	// DefaultLiveLocationInterval if not positive.
+	Interval time.Duration
+	// Until is when the live period of the message ends; zero means no limit.
+	Until time.Time
+}
+
+// NewLiveLocationUpdater returns an updater for a live location message sent by the bot.
+func (bot *TelegramBot) NewLiveLocationUpdater(msg *Message, interval time.Duration) *LiveLocationUpdater {
+	u := &LiveLocationUpdater{
+		bot:       bot,
+		ChatID:    msg.Chat.ID,
+		MessageID: msg.MessageID,
+		Interval:  interval,
+	}
+	if msg.Location != nil && msg.Location.LivePeriod > 0 && msg.Location.LivePeriod != 0x7FFFFFFF {
+		u.Until = time.Unix(int64(msg.Date), 0).Add(time.Duration(msg.Location.LivePeriod) * time.Second)
+	}
+	return u
+}
+
+// Run edits the message with the latest location received from locations,
+// at most once per Interval. It returns when ctx is cancelled or locations
+// is closed, stopping the live location, or when the live period ends.
+// Edits failing with a temporary error, such as a flood wait, are retried on
+// the next tick; other errors stop Run.
+func (u *LiveLocationUpdater) Run(ctx context.Context, locations <-chan *Location) error {
+	interval := u.Interval
+	if interval <= 0 {
+		interval = DefaultLiveLocationInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var end <-chan time.Time
+	if !u.Until.IsZero() {
+		timer := time.NewTimer(time.Until(u.Until))
+		defer timer.Stop()
+		end = timer.C
+	}
+	var latest *Location
+	for {
+		select {
+		case <-ctx.Done():
+			return u.stop()
+		case <-end:
+			return nil
+		case location, ok := <-locations:
+			if !ok {
+				if err := u.update(latest); err != nil && !isTemporary(err) {
+					return err
+				}
+				return u.stop()
+			}
+			latest = location
+		case <-ticker.C:
+			if err := u.update(latest); err != nil {
+				if !isTemporary(err) {
+					return err
+				}
+				continue
+			}
+			latest = nil
+		}
+	}
+}
+
+func (u *LiveLocationUpdater) update(location *Location) error {
+	if location == nil {
+		return nil
+	}
+	_, err := u.bot.EditMessageLiveLocation(&EditMessageLiveLocationRequest{
+		ChatID:               u.chatID(),
+		MessageID:            u.MessageID,
+		InlineMessageID:      u.InlineMessageID,
+		Latitude:             location.Latitude,
+		Longitude:            location.Longitude,
+		HorizontalAccuracy:   location.HorizontalAccuracy,
+		Heading:              location.Heading,
+		ProximityAlertRadius: location.ProximityAlertRadius,
+	})
+	if errors.Is(err, ErrMessageNotModified) {
+		return nil
+	}
+	return err
+}
+
+func (u *LiveLocationUpdater) stop() error {
+	_, err := u.bot.StopMessageLiveLocation(&StopMessageLiveLocationRequest{
+		ChatID:          u.chatID(),
+		MessageID:       u.MessageID,
+		InlineMessageID: u.InlineMessageID,
+	})
+	return err
+}
+
+// chatID omits the chat for inline messages.
+func (u *LiveLocationUpdater) chatID() any {
+	if u.InlineMessageID != "" {
+		return nil
+	}
+	return u.ChatID
+}
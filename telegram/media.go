@@ -0,0 +1,230 @@
+package telegram
+
+// File represents a file ready to be downloaded.
+// @docs https://core.telegram.org/bots/api#file
+type File struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int64  `json:"file_size,omitempty"`
+	FilePath     string `json:"file_path,omitempty"`
+}
+
+// PhotoSize represents one size of a photo or a file / sticker thumbnail.
+// @docs https://core.telegram.org/bots/api#photosize
+type PhotoSize struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// Animation represents an animation file (GIF or H.264/MPEG-4 AVC video without sound).
+// @docs https://core.telegram.org/bots/api#animation
+type Animation struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	Duration     int        `json:"duration"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int64      `json:"file_size,omitempty"`
+}
+
+// Audio represents an audio file to be treated as music by the Telegram clients.
+// @docs https://core.telegram.org/bots/api#audio
+type Audio struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Duration     int        `json:"duration"`
+	Performer    string     `json:"performer,omitempty"`
+	Title        string     `json:"title,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int64      `json:"file_size,omitempty"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// Document represents a general file (as opposed to photos, voice messages and audio files).
+// @docs https://core.telegram.org/bots/api#document
+type Document struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int64      `json:"file_size,omitempty"`
+}
+
+// Story represents a story.
+// @docs https://core.telegram.org/bots/api#story
+type Story struct {
+	Chat *Chat `json:"chat"`
+	ID   int64 `json:"id"`
+}
+
+// Video represents a video file.
+// @docs https://core.telegram.org/bots/api#video
+type Video struct {
+	FileID         string       `json:"file_id"`
+	FileUniqueID   string       `json:"file_unique_id"`
+	Width          int          `json:"width"`
+	Height         int          `json:"height"`
+	Duration       int          `json:"duration"`
+	Thumbnail      *PhotoSize   `json:"thumbnail,omitempty"`
+	Cover          []*PhotoSize `json:"cover,omitempty"`
+	StartTimestamp int          `json:"start_timestamp,omitempty"`
+	FileName       string       `json:"file_name,omitempty"`
+	MimeType       string       `json:"mime_type,omitempty"`
+	FileSize       int64        `json:"file_size,omitempty"`
+}
+
+// VideoNote represents a video message.
+// @docs https://core.telegram.org/bots/api#videonote
+type VideoNote struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Length       int        `json:"length"` // Video width and height (diameter of the video message)
+	Duration     int        `json:"duration"`
+	Thumbnail    *PhotoSize `json:"thumbnail,omitempty"`
+	FileSize     int64      `json:"file_size,omitempty"`
+}
+
+// Voice represents a voice note.
+// @docs https://core.telegram.org/bots/api#voice
+type Voice struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Duration     int    `json:"duration"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// Contact represents a phone contact.
+// @docs https://core.telegram.org/bots/api#contact
+type Contact struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+	UserID      int64  `json:"user_id,omitempty"`
+	VCard       string `json:"vcard,omitempty"`
+}
+
+// Dice represents an animated emoji that displays a random value.
+// @docs https://core.telegram.org/bots/api#dice
+type Dice struct {
+	Emoji DiceEmoji `json:"emoji"`
+	Value int       `json:"value"`
+}
+
+// PollOption contains information about one answer option in a poll.
+// @docs https://core.telegram.org/bots/api#polloption
+type PollOption struct {
+	Text         string           `json:"text"`
+	TextEntities []*MessageEntity `json:"text_entities,omitempty"`
+	VoterCount   int              `json:"voter_count"`
+}
+
+// Poll contains information about a poll.
+// @docs https://core.telegram.org/bots/api#poll
+type Poll struct {
+	ID                    string           `json:"id"`
+	Question              string           `json:"question"`
+	QuestionEntities      []*MessageEntity `json:"question_entities,omitempty"`
+	Options               []*PollOption    `json:"options"`
+	TotalVoterCount       int              `json:"total_voter_count"`
+	IsClosed              bool             `json:"is_closed"`
+	IsAnonymous           bool             `json:"is_anonymous"`
+	Type                  PollType         `json:"type"`
+	AllowsMultipleAnswers bool             `json:"allows_multiple_answers"`
+	// 0-based identifier of the correct answer option.
+	// Available only for polls in the quiz mode, which are closed, or was sent (not forwarded) by the bot or to the private chat with the bot.
+	CorrectOptionID     *int             `json:"correct_option_id,omitempty"`
+	Explanation         string           `json:"explanation,omitempty"`
+	ExplanationEntities []*MessageEntity `json:"explanation_entities,omitempty"`
+	OpenPeriod          int              `json:"open_period,omitempty"`
+	CloseDate           int64            `json:"close_date,omitempty"`
+}
+
+// PollAnswer represents an answer of a user in a non-anonymous poll.
+// @docs https://core.telegram.org/bots/api#pollanswer
+type PollAnswer struct {
+	PollID    string `json:"poll_id"`
+	VoterChat *Chat  `json:"voter_chat,omitempty"` // If the vote was cast by an anonymous chat administrator
+	User      *User  `json:"user,omitempty"`
+	OptionIDs []int  `json:"option_ids"` // Empty if the user retracted their vote
+}
+
+// Location represents a point on the map.
+// @docs https://core.telegram.org/bots/api#location
+type Location struct {
+	Latitude             float64 `json:"latitude"`
+	Longitude            float64 `json:"longitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int     `json:"live_period,omitempty"`
+	Heading              int     `json:"heading,omitempty"`
+	ProximityAlertRadius int     `json:"proximity_alert_radius,omitempty"`
+}
+
+// Venue represents a venue.
+// @docs https://core.telegram.org/bots/api#venue
+type Venue struct {
+	Location        *Location `json:"location"`
+	Title           string    `json:"title"`
+	Address         string    `json:"address"`
+	FoursquareID    string    `json:"foursquare_id,omitempty"`
+	FoursquareType  string    `json:"foursquare_type,omitempty"`
+	GooglePlaceID   string    `json:"google_place_id,omitempty"`
+	GooglePlaceType string    `json:"google_place_type,omitempty"`
+}
+
+// MaskPosition describes the position on faces where a mask should be placed by default.
+// @docs https://core.telegram.org/bots/api#maskposition
+type MaskPosition struct {
+	Point  string  `json:"point"` // "forehead" | "eyes" | "mouth" | "chin"
+	XShift float64 `json:"x_shift"`
+	YShift float64 `json:"y_shift"`
+	Scale  float64 `json:"scale"`
+}
+
+// Sticker represents a sticker.
+// @docs https://core.telegram.org/bots/api#sticker
+type Sticker struct {
+	FileID           string        `json:"file_id"`
+	FileUniqueID     string        `json:"file_unique_id"`
+	Type             string        `json:"type"` // "regular" | "mask" | "custom_emoji"
+	Width            int           `json:"width"`
+	Height           int           `json:"height"`
+	IsAnimated       bool          `json:"is_animated"`
+	IsVideo          bool          `json:"is_video"`
+	Thumbnail        *PhotoSize    `json:"thumbnail,omitempty"`
+	Emoji            string        `json:"emoji,omitempty"`
+	SetName          string        `json:"set_name,omitempty"`
+	PremiumAnimation *File         `json:"premium_animation,omitempty"`
+	MaskPosition     *MaskPosition `json:"mask_position,omitempty"`
+	CustomEmojiID    string        `json:"custom_emoji_id,omitempty"`
+	NeedsRepainting  bool          `json:"needs_repainting,omitempty"`
+	FileSize         int64         `json:"file_size,omitempty"`
+}
+
+// Game represents a game.
+// @docs https://core.telegram.org/bots/api#game
+type Game struct {
+	Title        string           `json:"title"`
+	Description  string           `json:"description"`
+	Photo        []*PhotoSize     `json:"photo"`
+	Text         string           `json:"text,omitempty"`
+	TextEntities []*MessageEntity `json:"text_entities,omitempty"`
+	Animation    *Animation       `json:"animation,omitempty"`
+}
+
+// ChatPhoto represents a chat photo.
+// @docs https://core.telegram.org/bots/api#chatphoto
+type ChatPhoto struct {
+	SmallFileID       string `json:"small_file_id"`
+	SmallFileUniqueID string `json:"small_file_unique_id"`
+	BigFileID         string `json:"big_file_id"`
+	BigFileUniqueID   string `json:"big_file_unique_id"`
+}
@@ -0,0 +1,93 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MenuButton describes the bot's menu button in a private chat, one of
+// *MenuButtonCommands, *MenuButtonWebApp or *MenuButtonDefault.
+// If a menu button other than MenuButtonDefault is set for a private chat, it is applied in the chat.
+// Otherwise the default menu button is applied. By default, the menu button opens the list of bot commands.
+// @docs https://core.telegram.org/bots/api#menubutton
+type MenuButton interface {
+	MenuButtonType() string
+}
+
+// MenuButtonCommands is a menu button which opens the bot's list of commands.
+// @docs https://core.telegram.org/bots/api#menubuttoncommands
+type MenuButtonCommands struct{}
+
+// MenuButtonWebApp is a menu button which launches a Web App.
+// @docs https://core.telegram.org/bots/api#menubuttonwebapp
+type MenuButtonWebApp struct {
+	Text   string      `json:"text"`
+	WebApp *WebAppInfo `json:"web_app"`
+}
+
+// MenuButtonDefault describes that no specific value for the menu button was set.
+// @docs https://core.telegram.org/bots/api#menubuttondefault
+type MenuButtonDefault struct{}
+
+func (b *MenuButtonCommands) MenuButtonType() string { return "commands" }
+func (b *MenuButtonWebApp) MenuButtonType() string   { return "web_app" }
+func (b *MenuButtonDefault) MenuButtonType() string  { return "default" }
+
+func (b *MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonCommands
+	return marshalUnion(b.MenuButtonType(), (*alias)(b))
+}
+
+func (b *MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonWebApp
+	return marshalUnion(b.MenuButtonType(), (*alias)(b))
+}
+
+func (b *MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonDefault
+	return marshalUnion(b.MenuButtonType(), (*alias)(b))
+}
+
+// UnmarshalMenuButton decodes a MenuButton according to its "type" field.
+func UnmarshalMenuButton(data []byte) (button MenuButton, err error) {
+	var head struct {
+		Type string `json:"type"`
+	}
+	if err = json.Unmarshal(data, &head); err != nil {
+		return
+	}
+	switch head.Type {
+	case "commands":
+		button = &MenuButtonCommands{}
+	case "web_app":
+		button = &MenuButtonWebApp{}
+	case "default":
+		button = &MenuButtonDefault{}
+	default:
+		return nil, fmt.Errorf("telegram: unknown menu button type %q", head.Type)
+	}
+	err = json.Unmarshal(data, button)
+	return
+}
+
+type ChatMenuButton struct {
+	ChatID     int64      `json:"chat_id,omitempty"` // The default menu button is changed if 0
+	MenuButton MenuButton `json:"menu_button,omitempty"`
+}
+
+// SetChatMenuButton changes the bot's menu button in a private chat, or the default menu button.
+// https://core.telegram.org/bots/api#setchatmenubutton
+func (bot *TelegramBot) SetChatMenuButton(button *ChatMenuButton) error {
+	return bot.CallMethod("setChatMenuButton", button, nil)
+}
+
+// GetChatMenuButton gets the current value of the bot's menu button in a private chat,
+// or the default menu button if chatID is 0.
+// https://core.telegram.org/bots/api#getchatmenubutton
+func (bot *TelegramBot) GetChatMenuButton(chatID int64) (button MenuButton, err error) {
+	var result json.RawMessage
+	if err = bot.CallMethod("getChatMenuButton", &ChatMenuButton{ChatID: chatID}, &result); err != nil {
+		return
+	}
+	return UnmarshalMenuButton(result)
+}
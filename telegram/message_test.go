@@ -0,0 +1,87 @@
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalMessage(t *testing.T) {
+	data := `{
+		"message_id": 42,
+		"from": {"id": 1, "is_bot": false, "first_name": "Alice"},
+		"sender_boost_count": 3,
+		"sender_business_bot": {"id": 2, "is_bot": true, "first_name": "Helper"},
+		"business_connection_id": "bc-1",
+		"date": 1700000000,
+		"chat": {"id": -100, "type": "supergroup", "title": "Group"},
+		"forward_origin": {"type": "channel", "date": 1690000000, "chat": {"id": -200, "type": "channel"}, "message_id": 7},
+		"reply_to_story": {"chat": {"id": -200, "type": "channel"}, "id": 5},
+		"is_from_offline": true,
+		"paid_star_count": 10,
+		"text": "hello",
+		"effect_id": "5104841245755180586",
+		"show_caption_above_media": true,
+		"photo": [{"file_id": "a", "file_unique_id": "b", "width": 90, "height": 60}],
+		"pinned_message": {"chat": {"id": -100, "type": "supergroup"}, "message_id": 3, "date": 0},
+		"reply_markup": {"inline_keyboard": [[{"text": "Open", "url": "https://example.com"}]]}
+	}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.SenderBoostCount != 3 || m.SenderBusinessBot == nil || m.SenderBusinessBot.ID != 2 {
+		t.Errorf("sender fields not decoded: %+v", m)
+	}
+	if m.BusinessConnectionID != "bc-1" || !m.IsFromOffline || m.PaidStarCount != 10 {
+		t.Errorf("business fields not decoded: %+v", m)
+	}
+	if m.EffectID != "5104841245755180586" || !m.ShowCaptionAboveMedia {
+		t.Errorf("effect fields not decoded: %+v", m)
+	}
+	if m.ReplyToStory == nil || m.ReplyToStory.ID != 5 {
+		t.Errorf("reply_to_story not decoded: %+v", m.ReplyToStory)
+	}
+	if !m.IsForwarded() || m.ForwardFromChat() == nil || m.ForwardFromChat().ID != -200 || m.ForwardFrom() != nil {
+		t.Errorf("forward origin not decoded: %+v", m.ForwardOrigin)
+	}
+	if len(m.Photo) != 1 || m.Photo[0].Width != 90 {
+		t.Errorf("photo not decoded: %+v", m.Photo)
+	}
+	if m.PinnedMessage.IsAccessible() {
+		t.Error("pinned message with zero date should be inaccessible")
+	}
+	if !m.IsServiceMessage() {
+		t.Error("message with pinned_message should be a service message")
+	}
+	if m.ReplyMarkup == nil || m.ReplyMarkup.InlineKeyboard[0][0].URL != "https://example.com" {
+		t.Errorf("reply_markup not decoded: %+v", m.ReplyMarkup)
+	}
+}
+
+func TestUnmarshalSuggestedPost(t *testing.T) {
+	data := `{
+		"message_id": 10,
+		"date": 1700000000,
+		"chat": {"id": -300, "type": "supergroup", "is_direct_messages": true},
+		"direct_messages_topic": {"topic_id": 4, "user": {"id": 1, "is_bot": false, "first_name": "Alice"}},
+		"suggested_post_paid": {
+			"suggested_post_message": {"message_id": 9, "date": 0, "chat": {"id": -300, "type": "supergroup"}},
+			"currency": "XTR",
+			"star_amount": {"amount": 50}
+		}
+	}`
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.DirectMessagesTopic == nil || m.DirectMessagesTopic.TopicID != 4 || m.DirectMessagesTopic.User.ID != 1 {
+		t.Errorf("direct_messages_topic not decoded: %+v", m.DirectMessagesTopic)
+	}
+	paid := m.SuggestedPostPaid
+	if paid == nil || paid.StarAmount == nil || paid.StarAmount.Amount != 50 || paid.SuggestedPostMessage.MessageID != 9 {
+		t.Errorf("suggested_post_paid not decoded: %+v", paid)
+	}
+	if !m.IsServiceMessage() {
+		t.Error("message with suggested_post_paid should be a service message")
+	}
+}
@@ -0,0 +1,210 @@
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ModerationAction is a sanction applied by a Moderator.
+type ModerationAction string
+
+const (
+	ModerationWarn ModerationAction = "warn"
+	ModerationMute ModerationAction = "mute"
+	ModerationKick ModerationAction = "kick"
+	ModerationBan  ModerationAction = "ban"
+)
+
+// EscalationStep applies Action for Duration once a user reaches Warnings warnings.
+type EscalationStep struct {
+	Warnings int
+	Action   ModerationAction
+	Duration time.Duration // 0 means forever, for ModerationMute and ModerationBan
+	// ResetWarnings clears the warnings of the user once the step is applied
+	ResetWarnings bool
+}
+
+// Moderator sanctions users of supergroups, counting their warnings in a Store
+// and escalating them according to Policy:
+//
+//	mod := telegram.NewModerator(bot, telegram.NewMemoryStore())
+//	mod.LogChatID = adminChatID
+//	mod.Warn(msg.Chat.ID, msg.From, "spam")
+//
+// The bot must be an administrator of the chats with the can_restrict_members right.
+type Moderator struct {
+	bot   *TelegramBot
+	store Store
+	// Policy is checked after each warning, the default mutes a user for a day
+	// on their 3rd warning and bans them on the 5th.
+	Policy []EscalationStep
+	// LogChatID, if set, receives a message for every action taken.
+	LogChatID any
+
+	mu    sync.Mutex
+	locks map[string]*warningsLock
+}
+
+// warningsLock serializes the updates of the warnings of a user,
+// shared by the n calls waiting for it.
+type warningsLock struct {
+	sync.Mutex
+	n int
+}
+
+// NewModerator returns a Moderator with the default policy, storing warnings in store.
+func NewModerator(bot *TelegramBot, store Store) *Moderator {
+	return &Moderator{
+		bot:   bot,
+		store: store,
+		Policy: []EscalationStep{
+			{Warnings: 3, Action: ModerationMute, Duration: 24 * time.Hour},
+			{Warnings: 5, Action: ModerationBan, ResetWarnings: true},
+		},
+	}
+}
+
+func warningsKey(chatID, userID int64) string {
+	return fmt.Sprintf("warnings:%d:%d", chatID, userID)
+}
+
+// Warnings returns the number of warnings of a user in a chat.
+func (m *Moderator) Warnings(chatID, userID int64) (int, error) {
+	value, ok, err := m.store.Get(warningsKey(chatID, userID))
+	if err != nil || !ok {
+		return 0, err
+	}
+	return strconv.Atoi(string(value))
+}
+
+// lock locks the warnings of a user in a chat, and returns the function unlocking them.
+func (m *Moderator) lock(chatID, userID int64) (unlock func()) {
+	key := warningsKey(chatID, userID)
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[string]*warningsLock)
+	}
+	l := m.locks[key]
+	if l == nil {
+		l = &warningsLock{}
+		m.locks[key] = l
+	}
+	l.n++
+	m.mu.Unlock()
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.mu.Lock()
+		if l.n--; l.n == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
+
+// ResetWarnings clears the warnings of a user in a chat.
+func (m *Moderator) ResetWarnings(chatID, userID int64) error {
+	defer m.lock(chatID, userID)()
+	return m.store.Delete(warningsKey(chatID, userID))
+}
+
+// Warn adds a warning to a user and applies the escalation step reached, if any.
+// It returns the number of warnings of the user. If the step fails, the warning
+// isn't counted, so that warning the user again retries it.
+func (m *Moderator) Warn(chatID int64, user *User, reason string) (warnings int, err error) {
+	defer m.lock(chatID, user.ID)()
+	current, err := m.Warnings(chatID, user.ID)
+	if err != nil {
+		return
+	}
+	warnings = current + 1
+	reset := false
+	for _, step := range m.Policy {
+		if step.Warnings != warnings {
+			continue
+		}
+		reason := fmt.Sprintf("%d warnings", warnings)
+		switch step.Action {
+		case ModerationMute:
+			err = m.Mute(chatID, user, step.Duration, reason)
+		case ModerationKick:
+			err = m.Kick(chatID, user, reason)
+		case ModerationBan:
+			err = m.Ban(chatID, user, step.Duration, reason)
+		}
+		if err != nil {
+			return current, err
+		}
+		reset = step.ResetWarnings
+		break
+	}
+	key := warningsKey(chatID, user.ID)
+	if reset {
+		err = m.store.Delete(key)
+	} else {
+		err = m.store.Set(key, []byte(strconv.Itoa(warnings)))
+	}
+	if err != nil {
+		return current, err
+	}
+	m.audit(chatID, user, ModerationWarn, 0, fmt.Sprintf("%s (%d)", reason, warnings))
+	return
+}
+
+// Mute prevents a user from sending messages for the duration d, or forever if d is 0.
+func (m *Moderator) Mute(chatID int64, user *User, d time.Duration, reason string) error {
+	if err := m.bot.MuteUser(chatID, user.ID, d); err != nil {
+		return err
+	}
+	m.audit(chatID, user, ModerationMute, d, reason)
+	return nil
+}
+
+// Unmute lifts the restrictions of a muted user.
+func (m *Moderator) Unmute(chatID int64, user *User) error {
+	return m.bot.UnmuteUser(chatID, user.ID)
+}
+
+// Kick removes a user from a chat, they can join again.
+func (m *Moderator) Kick(chatID int64, user *User, reason string) error {
+	err := m.bot.BanChatMember(&BanChatMemberRequest{ChatID: chatID, UserID: user.ID})
+	if err != nil {
+		return err
+	}
+	err = m.bot.UnbanChatMember(&UnbanChatMemberRequest{ChatID: chatID, UserID: user.ID, OnlyIfBanned: true})
+	if err != nil {
+		return err
+	}
+	m.audit(chatID, user, ModerationKick, 0, reason)
+	return nil
+}
+
+// Ban removes a user from a chat for the duration d, or forever if d is 0.
+func (m *Moderator) Ban(chatID int64, user *User, d time.Duration, reason string) error {
+	req := &BanChatMemberRequest{ChatID: chatID, UserID: user.ID}
+	if d > 0 {
+		req.UntilDate = UntilDate(d)
+	}
+	if err := m.bot.BanChatMember(req); err != nil {
+		return err
+	}
+	m.audit(chatID, user, ModerationBan, d, reason)
+	return nil
+}
+
+// audit reports an action to LogChatID. Failures are ignored, as the action was taken.
+func (m *Moderator) audit(chatID int64, user *User, action ModerationAction, d time.Duration, reason string) {
+	if m.LogChatID == nil {
+		return
+	}
+	text := fmt.Sprintf("#%s %s [%d] in %d", action, user.FullName(), user.ID, chatID)
+	if d > 0 {
+		text += " for " + d.String()
+	}
+	if reason != "" {
+		text += ": " + reason
+	}
+	m.bot.SendMessage(&MessageRequest{ChatID: m.LogChatID, Text: text})
+}
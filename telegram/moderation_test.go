@@ -0,0 +1,80 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestModeratorEscalation(t *testing.T) {
+	var calls []string
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		calls = append(calls, method)
+		if method == "restrictChatMember" {
+			var req RestrictChatMemberRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.UntilDate == 0 || req.Permissions.CanSendMessages {
+				t.Errorf("unexpected restriction %+v", req)
+			}
+		}
+		return true
+	})
+	mod := NewModerator(bot, NewMemoryStore())
+	user := &User{ID: 2, FirstName: "Spam"}
+	for i := 1; i <= 3; i++ {
+		n, err := mod.Warn(1, user, "spam")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != i {
+			t.Errorf("got %d warnings, want %d", n, i)
+		}
+	}
+	if len(calls) != 1 || calls[0] != "restrictChatMember" {
+		t.Errorf("calls: got %v", calls)
+	}
+}
+
+func TestModeratorWarnFailedStep(t *testing.T) {
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: not enough rights to restrict/unrestrict chat member"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer server.Close()
+	mod := NewModerator(NewBot(&Config{API: server.URL, Token: "test"}), NewMemoryStore())
+	mod.Policy = []EscalationStep{{Warnings: 1, Action: ModerationMute}}
+	user := &User{ID: 2}
+	if n, err := mod.Warn(1, user, "spam"); !errors.Is(err, ErrNotEnoughRights) || n != 0 {
+		t.Fatalf("got %d, %v", n, err)
+	}
+	fail = false
+	if n, err := mod.Warn(1, user, "spam"); err != nil || n != 1 {
+		t.Fatalf("the step wasn't retried: %d, %v", n, err)
+	}
+}
+
+func TestModeratorConcurrentWarnings(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any { return true })
+	mod := NewModerator(bot, NewMemoryStore())
+	mod.Policy = nil
+	user := &User{ID: 2}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mod.Warn(1, user, "spam")
+		}()
+	}
+	wg.Wait()
+	if n, _ := mod.Warnings(1, user.ID); n != 20 {
+		t.Errorf("got %d warnings, want 20", n)
+	}
+}
@@ -0,0 +1,64 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// writeMultipart writes form to w. Plain values are written first, followed
+// by the files, which are streamed from their readers without buffering.
+// Values can be *InputFile, *os.File or anything formatted with %v.
+func writeMultipart(ctx context.Context, w *multipart.Writer, form map[string]any) error {
+	var fields, files []string
+	for name, value := range form {
+		switch value.(type) {
+		case *InputFile, *os.File:
+			files = append(files, name)
+		default:
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	sort.Strings(files)
+	for _, name := range fields {
+		if err := w.WriteField(name, fmt.Sprintf("%v", form[name])); err != nil {
+			return err
+		}
+	}
+	for _, name := range files {
+		var err error
+		switch f := form[name].(type) {
+		case *InputFile:
+			err = writeInputFile(ctx, w, name, f)
+		case *os.File:
+			err = writeInputFile(ctx, w, name, &InputFile{Name: filepath.Base(f.Name()), Reader: f})
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeInputFile(ctx context.Context, w *multipart.Writer, name string, f *InputFile) error {
+	r, err := f.open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	part, err := w.CreateFormFile(name, f.Name)
+	if err != nil {
+		return err
+	}
+	pw := &progressWriter{w: part, total: f.size(), progress: f.Progress}
+	_, err = io.Copy(pw, &contextReader{ctx: ctx, r: r})
+	return err
+}
@@ -0,0 +1,100 @@
+package telegram
+
+// PaidMediaInfo describes the paid media added to a message.
+// @docs https://core.telegram.org/bots/api#paidmediainfo
+type PaidMediaInfo struct {
+	StarCount int          `json:"star_count"`
+	PaidMedia []*PaidMedia `json:"paid_media"`
+}
+
+// PaidMedia describes paid media.
+// @docs https://core.telegram.org/bots/api#paidmedia
+type PaidMedia struct {
+	Type string `json:"type"` // "preview" | "photo" | "video"
+	// "preview" only: the media isn't available before the payment
+	Width    int `json:"width,omitempty"`
+	Height   int `json:"height,omitempty"`
+	Duration int `json:"duration,omitempty"`
+	// "photo" only
+	Photo []*PhotoSize `json:"photo,omitempty"`
+	// "video" only
+	Video *Video `json:"video,omitempty"`
+}
+
+// IsPreview reports whether the media is a preview of media that isn't available before the payment.
+func (m *PaidMedia) IsPreview() bool {
+	return m.Type == "preview"
+}
+
+// PaidMediaPurchased contains information about a paid media purchase.
+// @docs https://core.telegram.org/bots/api#paidmediapurchased
+type PaidMediaPurchased struct {
+	From             *User  `json:"from"`
+	PaidMediaPayload string `json:"paid_media_payload"`
+}
+
+// InputPaidMedia describes the paid media to be sent.
+// It is one of InputPaidMediaPhoto or InputPaidMediaVideo.
+// @docs https://core.telegram.org/bots/api#inputpaidmedia
+type InputPaidMedia interface {
+	PaidMediaType() string
+}
+
+// InputPaidMediaPhoto is a paid photo to send.
+// @docs https://core.telegram.org/bots/api#inputpaidmediaphoto
+type InputPaidMediaPhoto struct {
+	Media *InputFile `json:"media"`
+}
+
+// InputPaidMediaVideo is a paid video to send.
+// @docs https://core.telegram.org/bots/api#inputpaidmediavideo
+type InputPaidMediaVideo struct {
+	Media             *InputFile `json:"media"`
+	Thumbnail         *InputFile `json:"thumbnail,omitempty"`
+	Cover             *InputFile `json:"cover,omitempty"`
+	StartTimestamp    int        `json:"start_timestamp,omitempty"`
+	Width             int        `json:"width,omitempty"`
+	Height            int        `json:"height,omitempty"`
+	Duration          int        `json:"duration,omitempty"`
+	SupportsStreaming bool       `json:"supports_streaming,omitempty"`
+}
+
+func (m *InputPaidMediaPhoto) PaidMediaType() string { return "photo" }
+func (m *InputPaidMediaVideo) PaidMediaType() string { return "video" }
+
+func (m *InputPaidMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputPaidMediaPhoto
+	return marshalUnion(m.PaidMediaType(), (*alias)(m))
+}
+
+func (m *InputPaidMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias InputPaidMediaVideo
+	return marshalUnion(m.PaidMediaType(), (*alias)(m))
+}
+
+type PaidMediaRequest struct {
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	StarCount               int                      `json:"star_count"` // The number of Telegram Stars that must be paid to buy access to the media; 1-10000
+	Media                   []InputPaidMedia         `json:"media"`      // 1-10 items
+	Payload                 string                   `json:"payload,omitempty"`
+	Caption                 string                   `json:"caption,omitempty"`
+	ParseMode               ParseMode                `json:"parse_mode,omitempty"`
+	CaptionEntities         []*MessageEntity         `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia   bool                     `json:"show_caption_above_media,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+}
+
+// SendPaidMedia sends paid media. Uploaded files are sent using the attach:// scheme.
+// @docs https://core.telegram.org/bots/api#sendpaidmedia
+func (bot *TelegramBot) SendPaidMedia(req *PaidMediaRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendPaidMedia", req, &result)
+	return
+}
@@ -0,0 +1,218 @@
+package telegram
+
+// PassportData describes Telegram Passport data shared with the bot by the user.
+// @docs https://core.telegram.org/bots/api#passportdata
+type PassportData struct {
+	Data        []*EncryptedPassportElement `json:"data"`
+	Credentials *EncryptedCredentials       `json:"credentials"`
+}
+
+// PassportFile represents a file uploaded to Telegram Passport.
+// Currently all Telegram Passport files are in JPEG format when decrypted and don't exceed 10MB.
+// @docs https://core.telegram.org/bots/api#passportfile
+type PassportFile struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int64  `json:"file_size"`
+	FileDate     int64  `json:"file_date"`
+}
+
+// EncryptedPassportElement describes documents or other Telegram Passport elements shared with the bot by the user.
+// @docs https://core.telegram.org/bots/api#encryptedpassportelement
+type EncryptedPassportElement struct {
+	// "personal_details" | "passport" | "driver_license" | "identity_card" | "internal_passport" | "address" |
+	// "utility_bill" | "bank_statement" | "rental_agreement" | "passport_registration" | "temporary_registration" |
+	// "phone_number" | "email"
+	Type        string          `json:"type"`
+	Data        string          `json:"data,omitempty"` // Base64-encoded encrypted element data
+	PhoneNumber string          `json:"phone_number,omitempty"`
+	Email       string          `json:"email,omitempty"`
+	Files       []*PassportFile `json:"files,omitempty"`
+	FrontSide   *PassportFile   `json:"front_side,omitempty"`
+	ReverseSide *PassportFile   `json:"reverse_side,omitempty"`
+	Selfie      *PassportFile   `json:"selfie,omitempty"`
+	Translation []*PassportFile `json:"translation,omitempty"`
+	Hash        string          `json:"hash"`
+}
+
+// EncryptedCredentials describes data required for decrypting and authenticating EncryptedPassportElement.
+// @docs https://core.telegram.org/bots/api#encryptedcredentials
+type EncryptedCredentials struct {
+	Data   string `json:"data"`   // Base64-encoded encrypted JSON-serialized data
+	Hash   string `json:"hash"`   // Base64-encoded data hash for data authentication
+	Secret string `json:"secret"` // Base64-encoded secret, encrypted with the bot's public RSA key
+}
+
+// Types of Telegram Passport elements, see EncryptedPassportElement.Type.
+const (
+	PassportElementPersonalDetails       = "personal_details"
+	PassportElementPassport              = "passport"
+	PassportElementDriverLicense         = "driver_license"
+	PassportElementIdentityCard          = "identity_card"
+	PassportElementInternalPassport      = "internal_passport"
+	PassportElementAddress               = "address"
+	PassportElementUtilityBill           = "utility_bill"
+	PassportElementBankStatement         = "bank_statement"
+	PassportElementRentalAgreement       = "rental_agreement"
+	PassportElementPassportRegistration  = "passport_registration"
+	PassportElementTemporaryRegistration = "temporary_registration"
+	PassportElementPhoneNumber           = "phone_number"
+	PassportElementEmail                 = "email"
+)
+
+// PassportElementError represents an error in the Telegram Passport element
+// which was submitted that should be resolved by the user. It is one of
+// PassportElementErrorDataField, PassportElementErrorFrontSide,
+// PassportElementErrorReverseSide, PassportElementErrorSelfie,
+// PassportElementErrorFile, PassportElementErrorFiles,
+// PassportElementErrorTranslationFile, PassportElementErrorTranslationFiles
+// or PassportElementErrorUnspecified.
+// @docs https://core.telegram.org/bots/api#passportelementerror
+type PassportElementError interface {
+	ErrorSource() string
+}
+
+// PassportElementErrorDataField represents an issue in one of the data fields that was provided by the user.
+// The error is considered resolved when the field's value changes.
+// @docs https://core.telegram.org/bots/api#passportelementerrordatafield
+type PassportElementErrorDataField struct {
+	Type      string `json:"type"` // "personal_details" | "passport" | "driver_license" | "identity_card" | "internal_passport" | "address"
+	FieldName string `json:"field_name"`
+	DataHash  string `json:"data_hash"` // Base64-encoded data hash
+	Message   string `json:"message"`
+}
+
+// PassportElementErrorFrontSide represents an issue with the front side of a document.
+// The error is considered resolved when the file with the front side of the document changes.
+// @docs https://core.telegram.org/bots/api#passportelementerrorfrontside
+type PassportElementErrorFrontSide struct {
+	Type     string `json:"type"` // "passport" | "driver_license" | "identity_card" | "internal_passport"
+	FileHash string `json:"file_hash"`
+	Message  string `json:"message"`
+}
+
+// PassportElementErrorReverseSide represents an issue with the reverse side of a document.
+// @docs https://core.telegram.org/bots/api#passportelementerrorreverseside
+type PassportElementErrorReverseSide struct {
+	Type     string `json:"type"` // "driver_license" | "identity_card"
+	FileHash string `json:"file_hash"`
+	Message  string `json:"message"`
+}
+
+// PassportElementErrorSelfie represents an issue with the selfie with a document.
+// @docs https://core.telegram.org/bots/api#passportelementerrorselfie
+type PassportElementErrorSelfie struct {
+	Type     string `json:"type"` // "passport" | "driver_license" | "identity_card" | "internal_passport"
+	FileHash string `json:"file_hash"`
+	Message  string `json:"message"`
+}
+
+// PassportElementErrorFile represents an issue with a document scan.
+// @docs https://core.telegram.org/bots/api#passportelementerrorfile
+type PassportElementErrorFile struct {
+	// "utility_bill" | "bank_statement" | "rental_agreement" | "passport_registration" | "temporary_registration"
+	Type     string `json:"type"`
+	FileHash string `json:"file_hash"`
+	Message  string `json:"message"`
+}
+
+// PassportElementErrorFiles represents an issue with a list of scans.
+// @docs https://core.telegram.org/bots/api#passportelementerrorfiles
+type PassportElementErrorFiles struct {
+	Type       string   `json:"type"`
+	FileHashes []string `json:"file_hashes"`
+	Message    string   `json:"message"`
+}
+
+// PassportElementErrorTranslationFile represents an issue with one of the files that constitute the translation of a document.
+// @docs https://core.telegram.org/bots/api#passportelementerrortranslationfile
+type PassportElementErrorTranslationFile struct {
+	Type     string `json:"type"`
+	FileHash string `json:"file_hash"`
+	Message  string `json:"message"`
+}
+
+// PassportElementErrorTranslationFiles represents an issue with the translated version of a document.
+// @docs https://core.telegram.org/bots/api#passportelementerrortranslationfiles
+type PassportElementErrorTranslationFiles struct {
+	Type       string   `json:"type"`
+	FileHashes []string `json:"file_hashes"`
+	Message    string   `json:"message"`
+}
+
+// PassportElementErrorUnspecified represents an issue in an unspecified place.
+// The error is considered resolved when new data is added.
+// @docs https://core.telegram.org/bots/api#passportelementerrorunspecified
+type PassportElementErrorUnspecified struct {
+	Type        string `json:"type"`
+	ElementHash string `json:"element_hash"`
+	Message     string `json:"message"`
+}
+
+func (e *PassportElementErrorDataField) ErrorSource() string        { return "data" }
+func (e *PassportElementErrorFrontSide) ErrorSource() string        { return "front_side" }
+func (e *PassportElementErrorReverseSide) ErrorSource() string      { return "reverse_side" }
+func (e *PassportElementErrorSelfie) ErrorSource() string           { return "selfie" }
+func (e *PassportElementErrorFile) ErrorSource() string             { return "file" }
+func (e *PassportElementErrorFiles) ErrorSource() string            { return "files" }
+func (e *PassportElementErrorTranslationFile) ErrorSource() string  { return "translation_file" }
+func (e *PassportElementErrorTranslationFiles) ErrorSource() string { return "translation_files" }
+func (e *PassportElementErrorUnspecified) ErrorSource() string      { return "unspecified" }
+
+func (e *PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorDataField
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFrontSide
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorReverseSide
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSelfie
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFile
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFiles
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorTranslationFile
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorTranslationFiles
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+func (e *PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorUnspecified
+	return marshalTagged("source", e.ErrorSource(), (*alias)(e))
+}
+
+type PassportDataErrorsRequest struct {
+	UserID int64                  `json:"user_id"`
+	Errors []PassportElementError `json:"errors"`
+}
+
+// SetPassportDataErrors informs a user that some of the Telegram Passport elements
+// they provided contains errors. The user will not be able to re-submit their Passport
+// to you until the errors are fixed.
+// https://core.telegram.org/bots/api#setpassportdataerrors
+func (bot *TelegramBot) SetPassportDataErrors(userID int64, errors []PassportElementError) error {
+	return bot.CallMethod("setPassportDataErrors", &PassportDataErrorsRequest{UserID: userID, Errors: errors}, nil)
+}
@@ -0,0 +1,184 @@
+package telegram
+
+import "fmt"
+
+// Invoice contains basic information about an invoice.
+// @docs https://core.telegram.org/bots/api#invoice
+type Invoice struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	StartParameter string `json:"start_parameter"`
+	Currency       string `json:"currency"` // Three-letter ISO 4217 currency code, or "XTR" for Telegram Stars
+	TotalAmount    int    `json:"total_amount"`
+}
+
+// ShippingAddress represents a shipping address.
+// @docs https://core.telegram.org/bots/api#shippingaddress
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"`
+	State       string `json:"state"`
+	City        string `json:"city"`
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2"`
+	PostCode    string `json:"post_code"`
+}
+
+// OrderInfo represents information about an order.
+// @docs https://core.telegram.org/bots/api#orderinfo
+type OrderInfo struct {
+	Name            string           `json:"name,omitempty"`
+	PhoneNumber     string           `json:"phone_number,omitempty"`
+	Email           string           `json:"email,omitempty"`
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"`
+}
+
+// SuccessfulPayment contains basic information about a successful payment.
+// Note that if the buyer initiates a chargeback with the relevant payment provider following this transaction,
+// the funds may be debited from your balance. This is outside of Telegram's control.
+// @docs https://core.telegram.org/bots/api#successfulpayment
+type SuccessfulPayment struct {
+	Currency                   string     `json:"currency"`
+	TotalAmount                int        `json:"total_amount"`
+	InvoicePayload             string     `json:"invoice_payload"`
+	SubscriptionExpirationDate int64      `json:"subscription_expiration_date,omitempty"`
+	IsRecurring                bool       `json:"is_recurring,omitempty"`
+	IsFirstRecurring           bool       `json:"is_first_recurring,omitempty"`
+	ShippingOptionID           string     `json:"shipping_option_id,omitempty"`
+	OrderInfo                  *OrderInfo `json:"order_info,omitempty"`
+	TelegramPaymentChargeID    string     `json:"telegram_payment_charge_id"`
+	ProviderPaymentChargeID    string     `json:"provider_payment_charge_id"`
+}
+
+// RefundedPayment contains basic information about a refunded payment.
+// @docs https://core.telegram.org/bots/api#refundedpayment
+type RefundedPayment struct {
+	Currency                string `json:"currency"` // Currently, always "XTR"
+	TotalAmount             int    `json:"total_amount"`
+	InvoicePayload          string `json:"invoice_payload"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	ProviderPaymentChargeID string `json:"provider_payment_charge_id,omitempty"`
+}
+
+// LabeledPrice represents a portion of the price for goods or services.
+// @docs https://core.telegram.org/bots/api#labeledprice
+type LabeledPrice struct {
+	Label string `json:"label"`
+	// Price of the product in the smallest units of the currency (integer, not float/double).
+	// For example, for a price of US$ 1.45 pass amount = 145.
+	Amount int `json:"amount"`
+}
+
+// CurrencyStars is the currency of payments in Telegram Stars, for digital goods and services.
+// Invoices in Stars take a single price and an empty provider token.
+const CurrencyStars = "XTR"
+
+// InvoiceRequest describes an invoice to send with SendInvoice.
+type InvoiceRequest struct {
+	ChatID                any    `json:"chat_id"`
+	MessageThreadID       int64  `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64  `json:"direct_messages_topic_id,omitempty"`
+	Title                 string `json:"title"`       // 1-32 characters
+	Description           string `json:"description"` // 1-255 characters
+	// Bot-defined invoice payload, 1-128 bytes, not displayed to the user
+	Payload       string          `json:"payload"`
+	ProviderToken string          `json:"provider_token,omitempty"` // Empty for payments in Telegram Stars
+	Currency      string          `json:"currency"`
+	Prices        []*LabeledPrice `json:"prices"`
+	// Maximum tip in the smallest units of the currency, not supported for payments in Telegram Stars
+	MaxTipAmount        int   `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts []int `json:"suggested_tip_amounts,omitempty"` // At most 4 positive increasing amounts
+	// Deep-linking parameter; if empty, forwarded copies of the message have an active Pay button,
+	// otherwise they have a URL button with a deep link to the bot
+	StartParameter            string `json:"start_parameter,omitempty"`
+	ProviderData              string `json:"provider_data,omitempty"` // JSON-serialized data shared with the payment provider
+	PhotoURL                  string `json:"photo_url,omitempty"`
+	PhotoSize                 int    `json:"photo_size,omitempty"`
+	PhotoWidth                int    `json:"photo_width,omitempty"`
+	PhotoHeight               int    `json:"photo_height,omitempty"`
+	NeedName                  bool   `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool   `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool   `json:"need_email,omitempty"`
+	NeedShippingAddress       bool   `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool   `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool   `json:"send_email_to_provider,omitempty"`
+	// The final price depends on the shipping method, see Router.OnShippingQuery
+	IsFlexible              bool                     `json:"is_flexible,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	// If empty, a Pay button is shown; otherwise its first button must be a PayButton
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StarsInvoice returns an invoice of amount Telegram Stars.
+func StarsInvoice(chatID any, title, description, payload string, amount int) *InvoiceRequest {
+	return &InvoiceRequest{
+		ChatID:      chatID,
+		Title:       title,
+		Description: description,
+		Payload:     payload,
+		Currency:    CurrencyStars,
+		Prices:      []*LabeledPrice{{Label: title, Amount: amount}},
+	}
+}
+
+// validatePayButton checks that a custom invoice keyboard starts with a pay button.
+func validatePayButton(markup *InlineKeyboardMarkup) error {
+	if markup == nil || len(markup.InlineKeyboard) == 0 {
+		return nil
+	}
+	if row := markup.InlineKeyboard[0]; len(row) == 0 || !row[0].Pay {
+		return fmt.Errorf("telegram: the first button of an invoice keyboard must be a pay button")
+	}
+	return nil
+}
+
+// SendInvoice sends an invoice.
+// https://core.telegram.org/bots/api#sendinvoice
+func (bot *TelegramBot) SendInvoice(req *InvoiceRequest) (result *Message, err error) {
+	if err = validatePayButton(req.ReplyMarkup); err != nil {
+		return
+	}
+	err = bot.CallMethod("sendInvoice", req, &result)
+	return
+}
+
+// InvoiceLinkRequest describes an invoice to create a link for with CreateInvoiceLink.
+type InvoiceLinkRequest struct {
+	// Business connection on behalf of which the link is created, for payments in Telegram Stars only
+	BusinessConnectionID string          `json:"business_connection_id,omitempty"`
+	Title                string          `json:"title"`
+	Description          string          `json:"description"`
+	Payload              string          `json:"payload"`
+	ProviderToken        string          `json:"provider_token,omitempty"`
+	Currency             string          `json:"currency"`
+	Prices               []*LabeledPrice `json:"prices"`
+	// Seconds after which the subscription is charged again, currently always 2592000 (30 days).
+	// Subscriptions are paid in Telegram Stars, with a price of at most 10000 Stars.
+	SubscriptionPeriod        int    `json:"subscription_period,omitempty"`
+	MaxTipAmount              int    `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts       []int  `json:"suggested_tip_amounts,omitempty"`
+	ProviderData              string `json:"provider_data,omitempty"`
+	PhotoURL                  string `json:"photo_url,omitempty"`
+	PhotoSize                 int    `json:"photo_size,omitempty"`
+	PhotoWidth                int    `json:"photo_width,omitempty"`
+	PhotoHeight               int    `json:"photo_height,omitempty"`
+	NeedName                  bool   `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool   `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool   `json:"need_email,omitempty"`
+	NeedShippingAddress       bool   `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool   `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool   `json:"send_email_to_provider,omitempty"`
+	IsFlexible                bool   `json:"is_flexible,omitempty"`
+}
+
+// CreateInvoiceLink creates a link for an invoice, which can be shared anywhere
+// or opened by a Mini App with Telegram.WebApp.openInvoice.
+// https://core.telegram.org/bots/api#createinvoicelink
+func (bot *TelegramBot) CreateInvoiceLink(req *InvoiceLinkRequest) (link string, err error) {
+	err = bot.CallMethod("createInvoiceLink", req, &link)
+	return
+}
@@ -0,0 +1,87 @@
+package telegram
+
+// BotName represents the bot's name.
+// @docs https://core.telegram.org/bots/api#botname
+type BotName struct {
+	Name string `json:"name"`
+}
+
+// BotDescription represents the bot's description.
+// @docs https://core.telegram.org/bots/api#botdescription
+type BotDescription struct {
+	Description string `json:"description"`
+}
+
+// BotShortDescription represents the bot's short description.
+// @docs https://core.telegram.org/bots/api#botshortdescription
+type BotShortDescription struct {
+	ShortDescription string `json:"short_description"`
+}
+
+// MyProfileRequest sets or gets a localized bot profile field.
+// An empty LanguageCode applies to all users without a dedicated value for their language.
+type MyProfileRequest struct {
+	Name             string `json:"name,omitempty"`              // SetMyName only, 0-64 characters
+	Description      string `json:"description,omitempty"`       // SetMyDescription only, 0-512 characters
+	ShortDescription string `json:"short_description,omitempty"` // SetMyShortDescription only, 0-120 characters
+	LanguageCode     string `json:"language_code,omitempty"`     // A two-letter ISO 639-1 language code
+}
+
+// SetMyName changes the bot's name, an empty name removes the dedicated name for the language.
+// https://core.telegram.org/bots/api#setmyname
+func (bot *TelegramBot) SetMyName(name, languageCode string) error {
+	return bot.CallMethod("setMyName", &MyProfileRequest{Name: name, LanguageCode: languageCode}, nil)
+}
+
+// GetMyName gets the bot's name for the given language.
+// https://core.telegram.org/bots/api#getmyname
+func (bot *TelegramBot) GetMyName(languageCode string) (name *BotName, err error) {
+	err = bot.CallMethod("getMyName", &MyProfileRequest{LanguageCode: languageCode}, &name)
+	return
+}
+
+// SetMyDescription changes the bot's description, shown in the chat with the bot if the chat is empty.
+// https://core.telegram.org/bots/api#setmydescription
+func (bot *TelegramBot) SetMyDescription(description, languageCode string) error {
+	return bot.CallMethod("setMyDescription", &MyProfileRequest{Description: description, LanguageCode: languageCode}, nil)
+}
+
+// GetMyDescription gets the bot's description for the given language.
+// https://core.telegram.org/bots/api#getmydescription
+func (bot *TelegramBot) GetMyDescription(languageCode string) (description *BotDescription, err error) {
+	err = bot.CallMethod("getMyDescription", &MyProfileRequest{LanguageCode: languageCode}, &description)
+	return
+}
+
+// SetMyShortDescription changes the bot's short description, shown on the bot's profile page
+// and sent together with the link when users share the bot.
+// https://core.telegram.org/bots/api#setmyshortdescription
+func (bot *TelegramBot) SetMyShortDescription(shortDescription, languageCode string) error {
+	return bot.CallMethod("setMyShortDescription", &MyProfileRequest{ShortDescription: shortDescription, LanguageCode: languageCode}, nil)
+}
+
+// GetMyShortDescription gets the bot's short description for the given language.
+// https://core.telegram.org/bots/api#getmyshortdescription
+func (bot *TelegramBot) GetMyShortDescription(languageCode string) (shortDescription *BotShortDescription, err error) {
+	err = bot.CallMethod("getMyShortDescription", &MyProfileRequest{LanguageCode: languageCode}, &shortDescription)
+	return
+}
+
+type MyDefaultAdministratorRightsRequest struct {
+	Rights      *ChatAdministratorRights `json:"rights,omitempty"`       // The default rights are cleared if nil
+	ForChannels bool                     `json:"for_channels,omitempty"` // Otherwise the rights for groups and supergroups
+}
+
+// SetMyDefaultAdministratorRights changes the default administrator rights requested by the bot
+// when it's added as an administrator to groups or channels.
+// https://core.telegram.org/bots/api#setmydefaultadministratorrights
+func (bot *TelegramBot) SetMyDefaultAdministratorRights(req *MyDefaultAdministratorRightsRequest) error {
+	return bot.CallMethod("setMyDefaultAdministratorRights", req, nil)
+}
+
+// GetMyDefaultAdministratorRights gets the current default administrator rights of the bot.
+// https://core.telegram.org/bots/api#getmydefaultadministratorrights
+func (bot *TelegramBot) GetMyDefaultAdministratorRights(forChannels bool) (rights *ChatAdministratorRights, err error) {
+	err = bot.CallMethod("getMyDefaultAdministratorRights", &MyDefaultAdministratorRightsRequest{ForChannels: forChannels}, &rights)
+	return
+}
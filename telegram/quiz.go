@@ -0,0 +1,251 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// QuizQuestion is a question of a Quiz.
+type QuizQuestion struct {
+	Question    string
+	Options     []string // 2-12 options
+	Correct     int      // Index of the correct option
+	Explanation string   // Shown to users who answer incorrectly
+	Points      int      // Awarded for a correct answer, 1 if zero
+}
+
+// QuizScore is the score of a user in a chat.
+type QuizScore struct {
+	UserID   int64  `json:"user_id"`
+	Name     string `json:"name"`
+	Points   int    `json:"points"`
+	Correct  int    `json:"correct"`
+	Answered int    `json:"answered"`
+}
+
+// quizPoll is a poll sent by a Quiz, stored by poll ID.
+type quizPoll struct {
+	ChatID   int64 `json:"chat_id"`
+	Question int   `json:"question"`
+}
+
+// Quiz sends questions as quiz polls and keeps the scores of users per chat
+// in a Store, from the answers they vote for:
+//
+//	quiz := telegram.NewQuiz(bot, store, "capitals",
+//		&telegram.QuizQuestion{Question: "Capital of France?", Options: []string{"Lyon", "Paris"}, Correct: 1},
+//		&telegram.QuizQuestion{Question: "Capital of Japan?", Options: []string{"Tokyo", "Osaka"}, Correct: 0},
+//	)
+//	quiz.Register(router)
+//	go quiz.Run(ctx, chatID)
+//	...
+//	leaders, err := quiz.Leaderboard(chatID, 10)
+//
+// The bot must receive poll_answer updates, which are only sent for non-anonymous polls.
+type Quiz struct {
+	bot       *TelegramBot
+	store     Store
+	name      string
+	Questions []*QuizQuestion
+	// OpenPeriod is the time users have to answer a question, 5-600 seconds, 30 by default.
+	OpenPeriod time.Duration
+
+	mu sync.Mutex
+}
+
+// NewQuiz returns a quiz of questions, identified by name in store.
+func NewQuiz(bot *TelegramBot, store Store, name string, questions ...*QuizQuestion) *Quiz {
+	return &Quiz{
+		bot:        bot,
+		store:      store,
+		name:       name,
+		Questions:  questions,
+		OpenPeriod: 30 * time.Second,
+	}
+}
+
+func (q *Quiz) pollKey(pollID string) string {
+	return fmt.Sprintf("quiz:%s:poll:%s", q.name, pollID)
+}
+
+func (q *Quiz) scoresKey(chatID int64) string {
+	return fmt.Sprintf("quiz:%s:scores:%d", q.name, chatID)
+}
+
+// Register handles the answers to the quiz's polls with router, and forgets
+// the polls once closed. Updates of other polls are left to the next handlers.
+func (q *Quiz) Register(router *Router) {
+	router.handle(func(update *Update) (bool, error) {
+		var pollID string
+		switch {
+		case update.PollAnswer != nil:
+			pollID = update.PollAnswer.PollID
+		case update.Poll != nil:
+			pollID = update.Poll.ID
+		default:
+			return false, nil
+		}
+		poll, err := q.poll(pollID)
+		if err != nil || poll == nil {
+			return err != nil, err
+		}
+		if update.Poll != nil {
+			if update.Poll.IsClosed {
+				err = q.store.Delete(q.pollKey(pollID))
+			}
+			return true, err
+		}
+		return true, q.answer(poll, update.PollAnswer)
+	})
+}
+
+func (q *Quiz) poll(pollID string) (poll *quizPoll, err error) {
+	value, ok, err := q.store.Get(q.pollKey(pollID))
+	if err != nil || !ok {
+		return
+	}
+	err = json.Unmarshal(value, &poll)
+	return
+}
+
+// Send sends a question of the quiz to a chat.
+func (q *Quiz) Send(chatID any, question int) (message *Message, err error) {
+	if question < 0 || question >= len(q.Questions) {
+		return nil, fmt.Errorf("telegram: quiz %s has no question %d", q.name, question)
+	}
+	if q.OpenPeriod < 5*time.Second || q.OpenPeriod > 600*time.Second {
+		return nil, fmt.Errorf("telegram: quiz %s open period must be 5-600 seconds, got %s", q.name, q.OpenPeriod)
+	}
+	qq := q.Questions[question]
+	options := make([]InputPollOption, len(qq.Options))
+	for i, option := range qq.Options {
+		options[i] = InputPollOption{Text: option}
+	}
+	anonymous, correct := false, qq.Correct
+	message, err = q.bot.SendPoll(&SendPollRequest{
+		ChatID:          chatID,
+		Question:        qq.Question,
+		Options:         options,
+		IsAnonymous:     &anonymous,
+		Type:            PollTypeQuiz,
+		CorrectOptionID: &correct,
+		Explanation:     qq.Explanation,
+		OpenPeriod:      int(q.OpenPeriod / time.Second),
+	})
+	if err != nil {
+		return
+	}
+	value, err := json.Marshal(&quizPoll{ChatID: message.Chat.ID, Question: question})
+	if err != nil {
+		return
+	}
+	err = q.store.Set(q.pollKey(message.Poll.ID), value)
+	return
+}
+
+// Run sends the questions of the quiz to a chat one after another,
+// leaving OpenPeriod to answer each of them. It blocks until the last
+// question is closed or ctx is done, so call it in a goroutine.
+func (q *Quiz) Run(ctx context.Context, chatID any) error {
+	for i := range q.Questions {
+		message, err := q.Send(chatID, i)
+		if err != nil {
+			return err
+		}
+		err = sleep(ctx, q.OpenPeriod)
+		// The poll is closed, or won't be followed anymore
+		if deleteErr := q.store.Delete(q.pollKey(message.Poll.ID)); err == nil {
+			err = deleteErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// answer records the answer of a user to a question.
+func (q *Quiz) answer(poll *quizPoll, answer *PollAnswer) error {
+	if answer.User == nil || len(answer.OptionIDs) == 0 || poll.Question >= len(q.Questions) {
+		return nil
+	}
+	question := q.Questions[poll.Question]
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	scores, err := q.scores(poll.ChatID)
+	if err != nil {
+		return err
+	}
+	score := scores[answer.User.ID]
+	if score == nil {
+		score = &QuizScore{UserID: answer.User.ID}
+		scores[answer.User.ID] = score
+	}
+	score.Name = answer.User.FirstName
+	score.Answered++
+	if answer.OptionIDs[0] == question.Correct {
+		score.Correct++
+		score.Points += max(question.Points, 1)
+	}
+	value, err := json.Marshal(scores)
+	if err != nil {
+		return err
+	}
+	return q.store.Set(q.scoresKey(poll.ChatID), value)
+}
+
+func (q *Quiz) scores(chatID int64) (scores map[int64]*QuizScore, err error) {
+	scores = make(map[int64]*QuizScore)
+	value, ok, err := q.store.Get(q.scoresKey(chatID))
+	if err != nil || !ok {
+		return
+	}
+	err = json.Unmarshal(value, &scores)
+	return
+}
+
+// Score returns the score of a user in a chat, nil if they never answered.
+func (q *Quiz) Score(chatID, userID int64) (*QuizScore, error) {
+	scores, err := q.scores(chatID)
+	if err != nil {
+		return nil, err
+	}
+	return scores[userID], nil
+}
+
+// Leaderboard returns the best scores of a chat, by points then correct answers,
+// at most limit of them if limit is positive.
+func (q *Quiz) Leaderboard(chatID int64, limit int) (leaders []*QuizScore, err error) {
+	scores, err := q.scores(chatID)
+	if err != nil {
+		return
+	}
+	for _, score := range scores {
+		leaders = append(leaders, score)
+	}
+	sort.Slice(leaders, func(i, j int) bool {
+		a, b := leaders[i], leaders[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Correct != b.Correct {
+			return a.Correct > b.Correct
+		}
+		return a.UserID < b.UserID
+	})
+	if limit > 0 && len(leaders) > limit {
+		leaders = leaders[:limit]
+	}
+	return
+}
+
+// Reset clears the scores of a chat.
+func (q *Quiz) Reset(chatID int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.store.Delete(q.scoresKey(chatID))
+}
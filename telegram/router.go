@@ -0,0 +1,289 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// Router dispatches updates to the handlers registered for their kind.
+// HandleUpdate has the signature expected by StartPolling:
+//
+//	router := telegram.NewRouter()
+//	router.OnJoinRequest(func(req *telegram.ChatJoinRequest) error {
+//		return bot.ApproveJoinRequest(req)
+//	})
+//	bot.StartPolling(ctx, router.HandleUpdate)
+//
+// Handlers are tried in the order they were registered, the first one
+// accepting an update handles it.
+type Router struct {
+	routes  []route
+	onError func(update *Update, err error)
+	// Codec encodes the payloads of callback data, see OnCallback. Defaults to DefaultCallbackCodec.
+	Codec CallbackCodec
+	// Store persists the keyboards saved with SaveKeyboard, see OnMenu.
+	// The default MemoryStore loses them when the bot restarts.
+	Store Store
+	// Bot sends the questions asked with Confirm.
+	Bot *TelegramBot
+	// Username is the username of the bot. If set, commands addressed to
+	// other bots with "/command@botname" are ignored by OnCommand.
+	Username string
+
+	mu       sync.Mutex
+	confirms map[string]chan *CallbackQuery
+}
+
+// route handles update and reports whether it accepted it.
+type route func(update *Update) (handled bool, err error)
+
+// NewRouter returns a router without handlers, keeping keyboards in memory.
+func NewRouter() *Router {
+	return &Router{Store: NewMemoryStore()}
+}
+
+// OnError sets the function called with polling errors and errors returned
+// by handlers, by default they are logged.
+func (r *Router) OnError(fn func(update *Update, err error)) {
+	r.onError = fn
+}
+
+// HandleUpdate dispatches the update to the first matching handler.
+func (r *Router) HandleUpdate(update *Update, err error) {
+	if err == nil && update != nil {
+		var handled bool
+		if handled, err = r.answerConfirm(update); !handled {
+			for _, route := range r.routes {
+				if handled, err = route(update); handled {
+					break
+				}
+			}
+		}
+	}
+	if err != nil {
+		if r.onError != nil {
+			r.onError(update, err)
+		} else {
+			log.Println("telegram:", err)
+		}
+	}
+}
+
+func (r *Router) handle(route route) {
+	r.routes = append(r.routes, route)
+}
+
+// OnJoinRequest handles requests to join a chat.
+// The bot must have the can_invite_users administrator right in the chat to receive them.
+func (r *Router) OnJoinRequest(fn func(req *ChatJoinRequest) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.ChatJoinRequest == nil {
+			return false, nil
+		}
+		return true, fn(update.ChatJoinRequest)
+	})
+}
+
+// OnMyChatMember handles changes of the bot's own status in chats,
+// e.g. when it's added to a group or blocked by a user.
+func (r *Router) OnMyChatMember(fn func(update *ChatMemberUpdated) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.MyChatMember == nil {
+			return false, nil
+		}
+		return true, fn(update.MyChatMember)
+	})
+}
+
+// OnChatMember handles changes of the status of chat members.
+// The bot must be an administrator in the chat and must explicitly specify
+// "chat_member" in the list of allowed_updates to receive them.
+func (r *Router) OnChatMember(fn func(update *ChatMemberUpdated) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.ChatMember == nil {
+			return false, nil
+		}
+		return true, fn(update.ChatMember)
+	})
+}
+
+// OnInlineQuery handles inline queries, answered with AnswerInlineQuery.
+func (r *Router) OnInlineQuery(fn func(query *InlineQuery) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.InlineQuery == nil {
+			return false, nil
+		}
+		return true, fn(update.InlineQuery)
+	})
+}
+
+// OnChosenInlineResult handles the inline results chosen by users.
+// Inline feedback must be enabled with the /setinlinefeedback command of @BotFather.
+func (r *Router) OnChosenInlineResult(fn func(result *ChosenInlineResult) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.ChosenInlineResult == nil {
+			return false, nil
+		}
+		return true, fn(update.ChosenInlineResult)
+	})
+}
+
+// OnCallbackQuery handles queries from the callback buttons of inline keyboards,
+// answered with AnswerCallbackQuery.
+func (r *Router) OnCallbackQuery(fn func(query *CallbackQuery) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.CallbackQuery == nil {
+			return false, nil
+		}
+		return true, fn(update.CallbackQuery)
+	})
+}
+
+// OnMessage handles new messages not handled by an earlier handler.
+func (r *Router) OnMessage(fn func(message *Message) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.Message == nil {
+			return false, nil
+		}
+		return true, fn(update.Message)
+	})
+}
+
+// OnCommand handles messages starting with the command, given without "/".
+// Commands addressed to the bot with "/command@botname" are handled as well,
+// set Username to ignore the commands addressed to other bots in groups.
+func (r *Router) OnCommand(command string, fn func(message *Message, args string) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.Message == nil {
+			return false, nil
+		}
+		name, args := update.Message.CommandFor(r.Username)
+		if name != command {
+			return false, nil
+		}
+		return true, fn(update.Message, args)
+	})
+}
+
+// OnStart handles the /start command, with the payload of the deep link
+// which opened the chat, if any (see StartLink and OnStartPayload).
+func (r *Router) OnStart(fn func(message *Message, payload string) error) {
+	r.OnCommand("start", fn)
+}
+
+// OnStartPayload handles the /start command like OnStart, with the data decoded
+// from a payload created by EncodeStartPayload, nil without payload.
+// Messages with an invalid payload are handled with an error.
+func (r *Router) OnStartPayload(fn func(message *Message, data []byte) error) {
+	r.OnStart(func(message *Message, payload string) error {
+		data, err := DecodeStartPayload(payload)
+		if err != nil {
+			return fmt.Errorf("telegram: start payload %q: %w", payload, err)
+		}
+		if len(data) == 0 {
+			data = nil
+		}
+		return fn(message, data)
+	})
+}
+
+// OnPoll handles changes of the state of polls, such as new votes.
+// The bot only receives updates about the polls it sent and stopped polls.
+func (r *Router) OnPoll(fn func(poll *Poll) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.Poll == nil {
+			return false, nil
+		}
+		return true, fn(update.Poll)
+	})
+}
+
+// OnPollAnswer handles the votes of users in the non-anonymous polls sent by the bot.
+func (r *Router) OnPollAnswer(fn func(answer *PollAnswer) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.PollAnswer == nil {
+			return false, nil
+		}
+		return true, fn(update.PollAnswer)
+	})
+}
+
+// OnShippingQuery handles the shipping queries of flexible invoices,
+// answered with AnswerShippingQuery or by a handler from HandleShippingQuery.
+func (r *Router) OnShippingQuery(fn func(query *ShippingQuery) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.ShippingQuery == nil {
+			return false, nil
+		}
+		return true, fn(update.ShippingQuery)
+	})
+}
+
+// OnPreCheckoutQuery handles pre-checkout queries, answered with
+// AnswerPreCheckoutQuery or by a handler from HandlePreCheckoutQuery.
+func (r *Router) OnPreCheckoutQuery(fn func(query *PreCheckoutQuery) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.PreCheckoutQuery == nil {
+			return false, nil
+		}
+		return true, fn(update.PreCheckoutQuery)
+	})
+}
+
+// OnGame handles the callback queries of the Play buttons of a game, or of
+// any game if shortName is empty, answered with OpenGame.
+func (r *Router) OnGame(shortName string, fn func(query *CallbackQuery) error) {
+	r.handle(func(update *Update) (bool, error) {
+		query := update.CallbackQuery
+		if query == nil || query.GameShortName == "" {
+			return false, nil
+		}
+		if shortName != "" && query.GameShortName != shortName {
+			return false, nil
+		}
+		return true, fn(query)
+	})
+}
+
+// OnBusinessConnection handles the bot being connected to or disconnected
+// from a business account, or the connection being edited.
+func (r *Router) OnBusinessConnection(fn func(connection *BusinessConnection) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.BusinessConnection == nil {
+			return false, nil
+		}
+		return true, fn(update.BusinessConnection)
+	})
+}
+
+// OnBusinessMessage handles new messages of the chats of connected business accounts.
+// Reply on behalf of the account with the BusinessConnectionID of the message.
+func (r *Router) OnBusinessMessage(fn func(message *Message) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.BusinessMessage == nil {
+			return false, nil
+		}
+		return true, fn(update.BusinessMessage)
+	})
+}
+
+// OnEditedBusinessMessage handles edits of the messages of connected business accounts.
+func (r *Router) OnEditedBusinessMessage(fn func(message *Message) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.EditedBusinessMessage == nil {
+			return false, nil
+		}
+		return true, fn(update.EditedBusinessMessage)
+	})
+}
+
+// OnDeletedBusinessMessages handles messages deleted from connected business accounts.
+func (r *Router) OnDeletedBusinessMessages(fn func(deleted *BusinessMessagesDeleted) error) {
+	r.handle(func(update *Update) (bool, error) {
+		if update.DeletedBusinessMessages == nil {
+			return false, nil
+		}
+		return true, fn(update.DeletedBusinessMessages)
+	})
+}
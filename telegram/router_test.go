@@ -0,0 +1,170 @@
+package telegram
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRouterJoinRequest(t *testing.T) {
+	router := NewRouter()
+	var got *ChatJoinRequest
+	router.OnJoinRequest(func(req *ChatJoinRequest) error {
+		got = req
+		return errors.New("declined")
+	})
+	var handlerErr error
+	router.OnError(func(update *Update, err error) {
+		handlerErr = err
+	})
+	router.HandleUpdate(&Update{Message: &Message{}}, nil)
+	if got != nil || handlerErr != nil {
+		t.Fatal("message update should not be handled")
+	}
+	req := &ChatJoinRequest{Chat: &Chat{ID: 1}, From: &User{ID: 2}, UserChatID: 2}
+	router.HandleUpdate(&Update{ChatJoinRequest: req}, nil)
+	if got != req {
+		t.Error("join request not handled")
+	}
+	if handlerErr == nil || handlerErr.Error() != "declined" {
+		t.Errorf("error: got %v", handlerErr)
+	}
+}
+
+func TestChatTracker(t *testing.T) {
+	bot := NewBot(&Config{Token: "test"})
+	store := NewMemoryStore()
+	tracker, err := bot.TrackChats(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := NewRouter()
+	router.OnMyChatMember(tracker.Handle)
+	var changes int
+	tracker.OnChange(func(update *ChatMemberUpdated) { changes++ })
+	join := func(chatID int64, status ChatMemberStatus) {
+		router.HandleUpdate(&Update{MyChatMember: &ChatMemberUpdated{
+			Chat:          &Chat{ID: chatID, Type: ChatTypeGroup},
+			NewChatMember: &ChatMember{Status: status},
+		}}, nil)
+	}
+	join(1, ChatMemberStatusMember)
+	join(2, ChatMemberStatusAdministrator)
+	join(1, ChatMemberStatusLeft)
+	chats := bot.Chats()
+	if len(chats) != 1 || chats[0].Chat.ID != 2 || changes != 3 {
+		t.Fatalf("got %d chats after %d changes", len(chats), changes)
+	}
+	// Reloaded from the store
+	reloaded, err := bot.TrackChats(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := reloaded.Chat(2); !ok {
+		t.Error("chat 2 not persisted")
+	}
+}
+
+func TestRouterInline(t *testing.T) {
+	router := NewRouter()
+	var query, chosen string
+	router.OnInlineQuery(func(q *InlineQuery) error {
+		query = q.Query
+		return nil
+	})
+	router.OnChosenInlineResult(func(r *ChosenInlineResult) error {
+		chosen = r.ResultID
+		return nil
+	})
+	router.HandleUpdate(&Update{InlineQuery: &InlineQuery{Query: "cats"}}, nil)
+	router.HandleUpdate(&Update{ChosenInlineResult: &ChosenInlineResult{ResultID: "7"}}, nil)
+	if query != "cats" || chosen != "7" {
+		t.Errorf("got query %q, chosen %q", query, chosen)
+	}
+}
+
+func TestRouterOnCallback(t *testing.T) {
+	type vote struct {
+		Poll   int `json:"p"`
+		Option int `json:"o"`
+	}
+	router := NewRouter()
+	var got vote
+	var gotMessage *Message
+	OnCallback(router, "vote", func(q *CallbackQuery, msg *Message, payload vote) error {
+		got, gotMessage = payload, msg
+		return nil
+	})
+	OnCallback(router, "page", func(q *CallbackQuery, msg *Message, page int) error {
+		t.Error("page handler called")
+		return nil
+	})
+	data, err := router.CallbackData("vote", vote{Poll: 1, Option: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &Message{MessageID: 5, Date: 1}
+	router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{Data: data, Message: msg}}, nil)
+	if got.Poll != 1 || got.Option != 2 || gotMessage != msg {
+		t.Errorf("got %+v %v", got, gotMessage)
+	}
+	if _, err := router.CallbackData("vote", strings.Repeat("x", 64)); err == nil {
+		t.Error("expected an error for oversized callback data")
+	}
+	if _, err := router.CallbackData("vote:1", 2); err == nil {
+		t.Error("expected an error for a prefix containing the separator")
+	}
+}
+
+func TestRouterOnMenu(t *testing.T) {
+	type cart struct{ Items []string }
+	store := NewMemoryStore()
+	before := NewRouter()
+	before.Store = store
+	msg := &Message{MessageID: 7, Date: 1, Chat: &Chat{ID: 1}}
+	if err := before.SaveKeyboard(msg, "cart", &cart{Items: []string{"apple"}}); err != nil {
+		t.Fatal(err)
+	}
+	// A new router sharing the store, as after a restart
+	router := NewRouter()
+	router.Store = store
+	var got *cart
+	OnMenu(router, "cart", func(query *CallbackQuery, message *Message, state *cart) error {
+		got = state
+		return nil
+	})
+	var orphaned bool
+	router.OnCallbackQuery(func(query *CallbackQuery) error {
+		orphaned = true
+		return nil
+	})
+	router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{Data: "checkout", Message: msg}}, nil)
+	if got == nil || len(got.Items) != 1 || got.Items[0] != "apple" || orphaned {
+		t.Fatalf("got %+v, orphaned %v", got, orphaned)
+	}
+	other := &Message{MessageID: 8, Date: 1, Chat: &Chat{ID: 1}}
+	router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{Data: "checkout", Message: other}}, nil)
+	if !orphaned {
+		t.Error("unknown keyboard should not be handled by the menu")
+	}
+}
+
+func TestRouterBusinessMessage(t *testing.T) {
+	router := NewRouter()
+	var messages, edits int
+	router.OnBusinessMessage(func(message *Message) error {
+		messages++
+		return nil
+	})
+	router.OnEditedBusinessMessage(func(message *Message) error {
+		edits++
+		return nil
+	})
+	msg := &Message{BusinessConnectionID: "conn", Chat: &Chat{ID: 1}}
+	router.HandleUpdate(&Update{BusinessMessage: msg}, nil)
+	router.HandleUpdate(&Update{EditedBusinessMessage: msg}, nil)
+	router.HandleUpdate(&Update{Message: msg}, nil)
+	if messages != 1 || edits != 1 {
+		t.Errorf("got %d messages and %d edits", messages, edits)
+	}
+}
@@ -0,0 +1,257 @@
+package telegram
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// schedulerKey is the Store key of the jobs of a Scheduler.
+const schedulerKey = "scheduler:jobs"
+
+// Failed jobs are retried after schedulerRetryDelay, doubled on each attempt
+// up to schedulerMaxRetryDelay, unless the API asks to wait longer.
+const (
+	schedulerRetryDelay    = 30 * time.Second
+	schedulerMaxRetryDelay = time.Hour
+)
+
+// ScheduledJob is a message to send or to delete at a given time.
+type ScheduledJob struct {
+	ID string    `json:"id"`
+	At time.Time `json:"at"`
+	// Message is the sendMessage request to send, or nil to delete MessageID
+	Message json.RawMessage `json:"message,omitempty"`
+	// TTL, if set, deletes the message after it has been sent for this long
+	TTL       time.Duration `json:"ttl,omitempty"`
+	ChatID    int64         `json:"chat_id,omitempty"`
+	MessageID int64         `json:"message_id,omitempty"`
+	// Attempts is the number of times the job failed
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// Scheduler sends and deletes messages later, keeping its jobs in a Store
+// so that they survive restarts:
+//
+//	scheduler, err := telegram.NewScheduler(bot, store)
+//	go scheduler.Run(ctx)
+//	scheduler.SendAt(time.Now().Add(time.Hour), &telegram.MessageRequest{ChatID: chatID, Text: "Reminder"})
+//	scheduler.SendWithTTL(&telegram.MessageRequest{ChatID: chatID, Text: "Code: 1234"}, time.Minute)
+//
+// Jobs due while the bot was stopped run as soon as Run is called again.
+// Messages can only be deleted by bots within 48 hours after they were sent.
+type Scheduler struct {
+	bot   *TelegramBot
+	store Store
+	mu    sync.Mutex
+	jobs  map[string]*ScheduledJob
+	wake  chan struct{}
+}
+
+// NewScheduler returns a Scheduler loading its pending jobs from store.
+func NewScheduler(bot *TelegramBot, store Store) (scheduler *Scheduler, err error) {
+	scheduler = &Scheduler{
+		bot:   bot,
+		store: store,
+		jobs:  make(map[string]*ScheduledJob),
+		wake:  make(chan struct{}, 1),
+	}
+	data, ok, err := store.Get(schedulerKey)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if err = json.Unmarshal(data, &scheduler.jobs); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// SendAt sends a text message at the given time, and returns the identifier of the job.
+func (s *Scheduler) SendAt(at time.Time, req *MessageRequest) (id string, err error) {
+	return s.ScheduleMessage(at, req, 0)
+}
+
+// SendWithTTL sends a text message now and deletes it after ttl.
+func (s *Scheduler) SendWithTTL(req *MessageRequest, ttl time.Duration) (message *Message, err error) {
+	if message, err = s.bot.SendMessage(req); err != nil {
+		return
+	}
+	_, err = s.DeleteAt(time.Now().Add(ttl), message.Chat.ID, message.MessageID)
+	return
+}
+
+// ScheduleMessage sends a text message at the given time and, if ttl isn't zero,
+// deletes it after ttl. It returns the identifier of the job.
+// The request is encoded when scheduled, so StyledText is applied and uploads aren't supported.
+func (s *Scheduler) ScheduleMessage(at time.Time, req *MessageRequest, ttl time.Duration) (id string, err error) {
+	message, err := json.Marshal(s.bot.prepareMessage(req))
+	if err != nil {
+		return
+	}
+	return s.Schedule(&ScheduledJob{At: at, Message: message, TTL: ttl})
+}
+
+// DeleteAt deletes a message at the given time, and returns the identifier of the job.
+func (s *Scheduler) DeleteAt(at time.Time, chatID, messageID int64) (id string, err error) {
+	return s.Schedule(&ScheduledJob{At: at, ChatID: chatID, MessageID: messageID})
+}
+
+// Schedule adds a job, assigning its ID if empty, and returns its identifier.
+func (s *Scheduler) Schedule(job *ScheduledJob) (id string, err error) {
+	if job.ID == "" {
+		nonce := make([]byte, 8)
+		if _, err = rand.Read(nonce); err != nil {
+			return
+		}
+		job.ID = hex.EncodeToString(nonce)
+	}
+	s.mu.Lock()
+	s.jobs[job.ID] = job
+	err = s.save()
+	s.mu.Unlock()
+	s.notify()
+	return job.ID, err
+}
+
+// Cancel removes a pending job.
+func (s *Scheduler) Cancel(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[id]; !ok {
+		return nil
+	}
+	delete(s.jobs, id)
+	return s.save()
+}
+
+// Jobs returns the pending jobs, ordered by time.
+func (s *Scheduler) Jobs() (jobs []*ScheduledJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, job := range s.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].At.Before(jobs[j].At)
+	})
+	return
+}
+
+// save persists the jobs, with s.mu held.
+func (s *Scheduler) save() error {
+	data, err := json.Marshal(s.jobs)
+	if err != nil {
+		return err
+	}
+	return s.store.Set(schedulerKey, data)
+}
+
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run runs the jobs when they are due, until ctx is done.
+// Failed jobs are logged and retried later with an increasing delay,
+// or dropped if the API rejected them, for example when the bot was blocked.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		var next <-chan time.Time
+		var timer *time.Timer
+		if jobs := s.Jobs(); len(jobs) > 0 {
+			timer = time.NewTimer(time.Until(jobs[0].At))
+			next = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-s.wake:
+		case <-next:
+			s.runDue()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// runDue runs the jobs which are due.
+func (s *Scheduler) runDue() {
+	now := time.Now()
+	for _, job := range s.Jobs() {
+		if job.At.After(now) {
+			return
+		}
+		if err := s.run(job); err != nil {
+			log.Println("telegram: scheduler:", err)
+		}
+	}
+}
+
+// run runs a job and replaces it by the deletion of the sent message if it has a TTL,
+// or by a later attempt if it failed with a temporary error.
+func (s *Scheduler) run(job *ScheduledJob) (err error) {
+	var next *ScheduledJob
+	if job.Message != nil {
+		var message *Message
+		if err = s.bot.CallMethod("sendMessage", job.Message, &message); err != nil {
+			err = fmt.Errorf("job %s: %w", job.ID, err)
+		} else if job.TTL > 0 {
+			next = &ScheduledJob{
+				ID:        job.ID,
+				At:        time.Now().Add(job.TTL),
+				ChatID:    message.Chat.ID,
+				MessageID: message.MessageID,
+			}
+		}
+	} else if err = s.bot.DeleteMessage(job.ChatID, job.MessageID); err != nil {
+		err = fmt.Errorf("job %s: %w", job.ID, err)
+	}
+	if err != nil && isTemporary(err) {
+		retry := *job
+		retry.Attempts++
+		retry.At = time.Now().Add(retryDelay(err, retry.Attempts))
+		next = &retry
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; !ok {
+		// Cancelled while running
+		next = nil
+	}
+	if next != nil {
+		s.jobs[job.ID] = next
+	} else {
+		delete(s.jobs, job.ID)
+	}
+	if saveErr := s.save(); err == nil {
+		err = saveErr
+	}
+	return
+}
+
+// retryDelay returns the delay before the attempt following a temporary error.
+func retryDelay(err error, attempts int) time.Duration {
+	delay := schedulerMaxRetryDelay
+	if attempts < 8 {
+		delay = min(schedulerRetryDelay<<(attempts-1), schedulerMaxRetryDelay)
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.Parameters != nil {
+		delay = max(delay, time.Duration(apiErr.Parameters.RetryAfter)*time.Second)
+	}
+	return delay
+}
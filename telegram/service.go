@@ -0,0 +1,219 @@
+package telegram
+
+// MessageOrigin describes the origin of a message.
+// @docs https://core.telegram.org/bots/api#messageorigin
+type MessageOrigin struct {
+	Type            string `json:"type"` // "user" | "hidden_user" | "chat" | "channel"
+	Date            int64  `json:"date"`
+	SenderUser      *User  `json:"sender_user,omitempty"`      // "user" only
+	SenderUserName  string `json:"sender_user_name,omitempty"` // "hidden_user" only
+	SenderChat      *Chat  `json:"sender_chat,omitempty"`      // "chat" only
+	Chat            *Chat  `json:"chat,omitempty"`             // "channel" only
+	MessageID       int64  `json:"message_id,omitempty"`       // "channel" only
+	AuthorSignature string `json:"author_signature,omitempty"` // "chat" and "channel" only
+}
+
+// ExternalReplyInfo contains information about a message that is being replied to,
+// which may come from another chat or forum topic.
+// @docs https://core.telegram.org/bots/api#externalreplyinfo
+type ExternalReplyInfo struct {
+	Origin             *MessageOrigin      `json:"origin"`
+	Chat               *Chat               `json:"chat,omitempty"`
+	MessageID          int64               `json:"message_id,omitempty"`
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	Animation          *Animation          `json:"animation,omitempty"`
+	Audio              *Audio              `json:"audio,omitempty"`
+	Document           *Document           `json:"document,omitempty"`
+	PaidMedia          *PaidMediaInfo      `json:"paid_media,omitempty"`
+	Photo              []*PhotoSize        `json:"photo,omitempty"`
+	Sticker            *Sticker            `json:"sticker,omitempty"`
+	Story              *Story              `json:"story,omitempty"`
+	Video              *Video              `json:"video,omitempty"`
+	VideoNote          *VideoNote          `json:"video_note,omitempty"`
+	Voice              *Voice              `json:"voice,omitempty"`
+	HasMediaSpoiler    bool                `json:"has_media_spoiler,omitempty"`
+	Checklist          *Checklist          `json:"checklist,omitempty"`
+	Contact            *Contact            `json:"contact,omitempty"`
+	Dice               *Dice               `json:"dice,omitempty"`
+	Game               *Game               `json:"game,omitempty"`
+	Giveaway           *Giveaway           `json:"giveaway,omitempty"`
+	GiveawayWinners    *GiveawayWinners    `json:"giveaway_winners,omitempty"`
+	Invoice            *Invoice            `json:"invoice,omitempty"`
+	Location           *Location           `json:"location,omitempty"`
+	Poll               *Poll               `json:"poll,omitempty"`
+	Venue              *Venue              `json:"venue,omitempty"`
+}
+
+// TextQuote contains information about the quoted part of a message that is replied to by the given message.
+// @docs https://core.telegram.org/bots/api#textquote
+type TextQuote struct {
+	Text     string           `json:"text"`
+	Entities []*MessageEntity `json:"entities,omitempty"`
+	Position int              `json:"position"` // In UTF-16 code units
+	IsManual bool             `json:"is_manual,omitempty"`
+}
+
+// MessageAutoDeleteTimerChanged represents a service message about a change in auto-delete timer settings.
+// @docs https://core.telegram.org/bots/api#messageautodeletetimerchanged
+type MessageAutoDeleteTimerChanged struct {
+	MessageAutoDeleteTime int `json:"message_auto_delete_time"`
+}
+
+// WriteAccessAllowed represents a service message about a user allowing a bot to write messages.
+// @docs https://core.telegram.org/bots/api#writeaccessallowed
+type WriteAccessAllowed struct {
+	FromRequest        bool   `json:"from_request,omitempty"`
+	WebAppName         string `json:"web_app_name,omitempty"`
+	FromAttachmentMenu bool   `json:"from_attachment_menu,omitempty"`
+}
+
+// ProximityAlertTriggered represents the content of a service message,
+// sent whenever a user in the chat triggers a proximity alert set by another user.
+// @docs https://core.telegram.org/bots/api#proximityalerttriggered
+type ProximityAlertTriggered struct {
+	Traveler *User `json:"traveler"`
+	Watcher  *User `json:"watcher"`
+	Distance int   `json:"distance"`
+}
+
+// ChatBoostAdded represents a service message about a user boosting a chat.
+// @docs https://core.telegram.org/bots/api#chatboostadded
+type ChatBoostAdded struct {
+	BoostCount int `json:"boost_count"`
+}
+
+// BackgroundFill describes the way a background is filled based on the selected colors.
+// @docs https://core.telegram.org/bots/api#backgroundfill
+type BackgroundFill struct {
+	Type          string `json:"type"` // "solid" | "gradient" | "freeform_gradient"
+	Color         int    `json:"color,omitempty"`
+	TopColor      int    `json:"top_color,omitempty"`
+	BottomColor   int    `json:"bottom_color,omitempty"`
+	RotationAngle int    `json:"rotation_angle,omitempty"`
+	Colors        []int  `json:"colors,omitempty"`
+}
+
+// BackgroundType describes the type of a background.
+// @docs https://core.telegram.org/bots/api#backgroundtype
+type BackgroundType struct {
+	Type             string          `json:"type"` // "fill" | "wallpaper" | "pattern" | "chat_theme"
+	Fill             *BackgroundFill `json:"fill,omitempty"`
+	Document         *Document       `json:"document,omitempty"`
+	DarkThemeDimming int             `json:"dark_theme_dimming,omitempty"`
+	Intensity        int             `json:"intensity,omitempty"`
+	IsBlurred        bool            `json:"is_blurred,omitempty"`
+	IsMoving         bool            `json:"is_moving,omitempty"`
+	IsInverted       bool            `json:"is_inverted,omitempty"`
+	ThemeName        string          `json:"theme_name,omitempty"`
+}
+
+// ChatBackground represents a chat background.
+// @docs https://core.telegram.org/bots/api#chatbackground
+type ChatBackground struct {
+	Type *BackgroundType `json:"type"`
+}
+
+// ForumTopicCreated represents a service message about a new forum topic created in the chat.
+// @docs https://core.telegram.org/bots/api#forumtopiccreated
+type ForumTopicCreated struct {
+	Name              string `json:"name"`
+	IconColor         int    `json:"icon_color"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicEdited represents a service message about an edited forum topic.
+// @docs https://core.telegram.org/bots/api#forumtopicedited
+type ForumTopicEdited struct {
+	Name              string  `json:"name,omitempty"`
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"` // Empty string if the icon was removed
+}
+
+// ForumTopicClosed represents a service message about a forum topic closed in the chat.
+// @docs https://core.telegram.org/bots/api#forumtopicclosed
+type ForumTopicClosed struct{}
+
+// ForumTopicReopened represents a service message about a forum topic reopened in the chat.
+// @docs https://core.telegram.org/bots/api#forumtopicreopened
+type ForumTopicReopened struct{}
+
+// GeneralForumTopicHidden represents a service message about General forum topic hidden in the chat.
+// @docs https://core.telegram.org/bots/api#generalforumtopichidden
+type GeneralForumTopicHidden struct{}
+
+// GeneralForumTopicUnhidden represents a service message about General forum topic unhidden in the chat.
+// @docs https://core.telegram.org/bots/api#generalforumtopicunhidden
+type GeneralForumTopicUnhidden struct{}
+
+// GiveawayCreated represents a service message about the creation of a scheduled giveaway.
+// @docs https://core.telegram.org/bots/api#giveawaycreated
+type GiveawayCreated struct {
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+}
+
+// Giveaway represents a message about a scheduled giveaway.
+// @docs https://core.telegram.org/bots/api#giveaway
+type Giveaway struct {
+	Chats                         []*Chat  `json:"chats"`
+	WinnersSelectionDate          int64    `json:"winners_selection_date"`
+	WinnerCount                   int      `json:"winner_count"`
+	OnlyNewMembers                bool     `json:"only_new_members,omitempty"`
+	HasPublicWinners              bool     `json:"has_public_winners,omitempty"`
+	PrizeDescription              string   `json:"prize_description,omitempty"`
+	CountryCodes                  []string `json:"country_codes,omitempty"`
+	PrizeStarCount                int      `json:"prize_star_count,omitempty"`
+	PremiumSubscriptionMonthCount int      `json:"premium_subscription_month_count,omitempty"`
+}
+
+// GiveawayWinners represents a message about the completion of a giveaway with public winners.
+// @docs https://core.telegram.org/bots/api#giveawaywinners
+type GiveawayWinners struct {
+	Chat                          *Chat   `json:"chat"`
+	GiveawayMessageID             int64   `json:"giveaway_message_id"`
+	WinnersSelectionDate          int64   `json:"winners_selection_date"`
+	WinnerCount                   int     `json:"winner_count"`
+	Winners                       []*User `json:"winners"`
+	AdditionalChatCount           int     `json:"additional_chat_count,omitempty"`
+	PrizeStarCount                int     `json:"prize_star_count,omitempty"`
+	PremiumSubscriptionMonthCount int     `json:"premium_subscription_month_count,omitempty"`
+	UnclaimedPrizeCount           int     `json:"unclaimed_prize_count,omitempty"`
+	OnlyNewMembers                bool    `json:"only_new_members,omitempty"`
+	WasRefunded                   bool    `json:"was_refunded,omitempty"`
+	PrizeDescription              string  `json:"prize_description,omitempty"`
+}
+
+// GiveawayCompleted represents a service message about the completion of a giveaway without public winners.
+// @docs https://core.telegram.org/bots/api#giveawaycompleted
+type GiveawayCompleted struct {
+	WinnerCount         int      `json:"winner_count"`
+	UnclaimedPrizeCount int      `json:"unclaimed_prize_count,omitempty"`
+	GiveawayMessage     *Message `json:"giveaway_message,omitempty"`
+	IsStarGiveaway      bool     `json:"is_star_giveaway,omitempty"`
+}
+
+// PaidMessagePriceChanged describes a service message about a change in the price of paid messages within a chat.
+// @docs https://core.telegram.org/bots/api#paidmessagepricechanged
+type PaidMessagePriceChanged struct {
+	PaidMessageStarCount int `json:"paid_message_star_count"`
+}
+
+// VideoChatScheduled represents a service message about a video chat scheduled in the chat.
+// @docs https://core.telegram.org/bots/api#videochatscheduled
+type VideoChatScheduled struct {
+	StartDate int64 `json:"start_date"`
+}
+
+// VideoChatStarted represents a service message about a video chat started in the chat.
+// @docs https://core.telegram.org/bots/api#videochatstarted
+type VideoChatStarted struct{}
+
+// VideoChatEnded represents a service message about a video chat ended in the chat.
+// @docs https://core.telegram.org/bots/api#videochatended
+type VideoChatEnded struct {
+	Duration int `json:"duration"`
+}
+
+// VideoChatParticipantsInvited represents a service message about new members invited to a video chat.
+// @docs https://core.telegram.org/bots/api#videochatparticipantsinvited
+type VideoChatParticipantsInvited struct {
+	Users []*User `json:"users"`
+}
@@ -0,0 +1,389 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Length limits of message texts and media captions, in UTF-16 code units.
+const (
+	MaxMessageLength = 4096
+	MaxCaptionLength = 1024
+)
+
+// TextChunk is a part of a text split by SplitText, with its own entities.
+type TextChunk struct {
+	Text     string
+	Entities []*MessageEntity
+}
+
+// SplitText splits text into chunks of at most limit UTF-16 code units.
+// Chunks are cut at line breaks, or else at spaces, preferably outside of entities.
+// Entities spanning a cut are split between both chunks. With a parse mode,
+// HTML tags and Markdown markers open at a cut are closed and reopened in
+// the next chunk, and the length is measured on the markup. Limits below 1 are
+// taken as 1, and a surrogate pair is never split, even if it exceeds the limit.
+func SplitText(text string, mode ParseMode, entities []*MessageEntity, limit int) (chunks []*TextChunk) {
+	limit = max(limit, 1)
+	rest := &TextChunk{Text: text, Entities: entities}
+	for rest != nil {
+		var head *TextChunk
+		head, rest = splitChunk(rest, mode, limit)
+		chunks = append(chunks, head)
+		if rest != nil && rest.Text == "" {
+			// Cut at the end of the text
+			rest = nil
+		}
+	}
+	return
+}
+
+// SplitCaption splits a caption which may exceed MaxCaptionLength into the
+// caption itself and the remaining text, to be sent as follow-up messages.
+func SplitCaption(caption string, mode ParseMode, entities []*MessageEntity) (head *TextChunk, rest []*TextChunk) {
+	head, tail := splitChunk(&TextChunk{Text: caption, Entities: entities}, mode, MaxCaptionLength)
+	if tail != nil {
+		rest = SplitText(tail.Text, mode, tail.Entities, MaxMessageLength)
+	}
+	return
+}
+
+// SendLongMessage sends a text of any length, split into as many messages as
+// needed by SplitText. ReplyParameters apply to the first message and
+// ReplyMarkup to the last one. See also MessageRequest.Split.
+func (bot *TelegramBot) SendLongMessage(message *MessageRequest) (results []*Message, err error) {
+	message = bot.prepareMessage(message)
+	chunks := SplitText(message.Text, message.ParseMode, message.Entities, MaxMessageLength)
+	for i, chunk := range chunks {
+		req := *message
+		req.Text, req.Entities = chunk.Text, chunk.Entities
+		if i > 0 {
+			req.ReplyParameters = nil
+		}
+		if i < len(chunks)-1 {
+			req.ReplyMarkup = nil
+		}
+		msg, err := bot.SendMessage(&req)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, msg)
+	}
+	return
+}
+
+func splitChunk(c *TextChunk, mode ParseMode, limit int) (head, tail *TextChunk) {
+	if UTF16Len(c.Text) <= limit {
+		return c, nil
+	}
+	scanner := newMarkupScanner(mode)
+	if scanner == nil {
+		return splitEntities(c, limit)
+	}
+	h, t := splitMarkup(c.Text, scanner, limit)
+	return &TextChunk{Text: h}, &TextChunk{Text: t}
+}
+
+// splitEntities splits a plain text, adjusting the entities of both parts.
+func splitEntities(c *TextChunk, limit int) (head, tail *TextChunk) {
+	units := utf16.Encode([]rune(c.Text))
+	inEntity := func(i int) bool {
+		for _, e := range c.Entities {
+			if e.Offset < i && i < e.Offset+e.Length {
+				return true
+			}
+		}
+		return false
+	}
+	find := func(sep uint16, outside bool) int {
+		for i := limit; i > limit/2; i-- {
+			if units[i] == sep && !(outside && inEntity(i)) {
+				return i
+			}
+		}
+		return -1
+	}
+	cut, skip := -1, 1
+	for _, try := range []struct {
+		sep     uint16
+		outside bool
+	}{{'\n', true}, {' ', true}, {'\n', false}, {' ', false}} {
+		if cut = find(try.sep, try.outside); cut >= 0 {
+			break
+		}
+	}
+	if cut < 0 {
+		cut, skip = surrogateCut(units, limit), 0
+	}
+	next := cut + skip
+	head = &TextChunk{Text: string(utf16.Decode(units[:cut]))}
+	tail = &TextChunk{Text: string(utf16.Decode(units[next:]))}
+	for _, e := range c.Entities {
+		end := e.Offset + e.Length
+		if e.Offset < cut {
+			h := *e
+			h.Length = min(end, cut) - e.Offset
+			head.Entities = append(head.Entities, &h)
+		}
+		if end > next {
+			t := *e
+			start := max(e.Offset, next)
+			t.Offset, t.Length = start-next, end-start
+			tail.Entities = append(tail.Entities, &t)
+		}
+	}
+	return
+}
+
+// markupScanner tracks the formatting open at a position of a marked up text.
+type markupScanner interface {
+	// step consumes the markup or character at r[i] and returns the number of
+	// runes consumed. Tags, links and escapes are consumed whole.
+	step(r []rune, i int) int
+	// closers returns the markup closing everything open.
+	closers() string
+	// openers returns the markup reopening everything open.
+	openers() string
+}
+
+func newMarkupScanner(mode ParseMode) markupScanner {
+	switch mode {
+	case ParseModeHTML:
+		return &htmlScanner{}
+	case ParseModeMarkdownV2:
+		return &markdownScanner{v2: true}
+	case ParseModeMarkdown:
+		return &markdownScanner{}
+	}
+	return nil
+}
+
+// splitMarkup cuts text at the best position fitting in limit, closing the
+// formatting open there in head and reopening it in tail.
+func splitMarkup(text string, scanner markupScanner, limit int) (head, tail string) {
+	type cutPoint struct {
+		pos, width       int
+		closers, openers string
+	}
+	runes := []rune(text)
+	var newline, space, last *cutPoint
+	width := 0
+	for i := 0; i < len(runes); {
+		// Only cut past the markup reopened in tail, so that head has some content
+		closers, openers := scanner.closers(), scanner.openers()
+		if i > len([]rune(openers)) && width+UTF16Len(closers) <= limit {
+			c := &cutPoint{pos: i, width: width, closers: closers, openers: openers}
+			switch runes[i] {
+			case '\n':
+				newline = c
+			case ' ':
+				space = c
+			}
+			last = c
+		}
+		n := scanner.step(runes, i)
+		width += UTF16Len(string(runes[i : i+n]))
+		if width > limit {
+			break
+		}
+		i += n
+	}
+	cut, skip := last, 0
+	switch {
+	case newline != nil && newline.width > limit/2:
+		cut, skip = newline, 1
+	case space != nil && space.width > limit/2:
+		cut, skip = space, 1
+	case newline != nil:
+		cut, skip = newline, 1
+	case space != nil:
+		cut, skip = space, 1
+	}
+	if cut == nil {
+		// A single tag or link longer than limit, cut it regardless
+		units := utf16.Encode(runes)
+		n := surrogateCut(units, limit)
+		return string(utf16.Decode(units[:n])), string(utf16.Decode(units[n:]))
+	}
+	return string(runes[:cut.pos]) + cut.closers, cut.openers + string(runes[cut.pos+skip:])
+}
+
+// surrogateCut moves a cut of units off a surrogate pair, backwards unless
+// the pair starts the text.
+func surrogateCut(units []uint16, cut int) int {
+	if units[cut] >= 0xDC00 && units[cut] <= 0xDFFF {
+		if cut == 1 {
+			return 2
+		}
+		return cut - 1
+	}
+	return cut
+}
+
+// htmlScanner tracks the open tags of a text formatted with ParseModeHTML.
+type htmlScanner struct {
+	open []string
+}
+
+func (s *htmlScanner) step(r []rune, i int) int {
+	switch r[i] {
+	case '<':
+		end := indexRune(r, i, '>')
+		if end < 0 {
+			return 1
+		}
+		tag := string(r[i : end+1])
+		if name, ok := strings.CutPrefix(tag, "</"); ok {
+			name = strings.TrimSuffix(name, ">")
+			for j := len(s.open) - 1; j >= 0; j-- {
+				if htmlTagName(s.open[j]) == name {
+					s.open = append(s.open[:j], s.open[j+1:]...)
+					break
+				}
+			}
+		} else {
+			s.open = append(s.open, tag)
+		}
+		return end - i + 1
+	case '&':
+		if end := indexRune(r, i, ';'); end > i && end-i <= 8 {
+			return end - i + 1
+		}
+	}
+	return 1
+}
+
+func (s *htmlScanner) closers() string {
+	var b strings.Builder
+	for j := len(s.open) - 1; j >= 0; j-- {
+		b.WriteString("</" + htmlTagName(s.open[j]) + ">")
+	}
+	return b.String()
+}
+
+func (s *htmlScanner) openers() string {
+	return strings.Join(s.open, "")
+}
+
+// htmlTagName returns the name of an opening tag, e.g. "a" for `<a href="...">`.
+func htmlTagName(tag string) string {
+	tag = strings.TrimSuffix(strings.TrimPrefix(tag, "<"), ">")
+	if i := strings.IndexAny(tag, " \t\n"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// markdownScanner tracks the open markers of a text formatted with
+// ParseModeMarkdownV2, or the legacy ParseModeMarkdown if v2 is false.
+type markdownScanner struct {
+	v2   bool
+	open []markdownMarker
+}
+
+type markdownMarker struct {
+	marker string // as found in the text, used to match the closing marker
+	open   string // reopens the formatting, e.g. "```go\n"
+	close  string
+}
+
+func (s *markdownScanner) top() string {
+	if len(s.open) == 0 {
+		return ""
+	}
+	return s.open[len(s.open)-1].marker
+}
+
+func (s *markdownScanner) toggle(marker string) int {
+	if s.top() == marker {
+		s.open = s.open[:len(s.open)-1]
+	} else {
+		s.open = append(s.open, markdownMarker{marker: marker, open: marker, close: marker})
+	}
+	return len([]rune(marker))
+}
+
+func (s *markdownScanner) step(r []rune, i int) int {
+	escape := s.v2 && r[i] == '\\' && i+1 < len(r)
+	switch s.top() {
+	case "```":
+		if hasRunePrefix(r, i, "```") {
+			s.open = s.open[:len(s.open)-1]
+			return 3
+		}
+		if escape {
+			return 2
+		}
+		return 1
+	case "`":
+		if r[i] == '`' {
+			return s.toggle("`")
+		}
+		if escape {
+			return 2
+		}
+		return 1
+	}
+	switch {
+	case escape:
+		return 2
+	case hasRunePrefix(r, i, "```"):
+		// The language, if any, is given on the rest of the opening line
+		end := indexRune(r, i, '\n')
+		if end < 0 {
+			return s.toggle("```")
+		}
+		s.open = append(s.open, markdownMarker{marker: "```", open: string(r[i : end+1]), close: "\n```"})
+		return end - i + 1
+	case r[i] == '`':
+		return s.toggle("`")
+	case s.v2 && (hasRunePrefix(r, i, "__") || hasRunePrefix(r, i, "||")):
+		return s.toggle(string(r[i : i+2]))
+	case r[i] == '*' || r[i] == '_' || (s.v2 && r[i] == '~'):
+		return s.toggle(string(r[i]))
+	case r[i] == '[':
+		// Keep [text](url) links together
+		if end := indexRune(r, i, ']'); end > 0 && end+1 < len(r) && r[end+1] == '(' {
+			if close := indexRune(r, end, ')'); close > 0 {
+				return close - i + 1
+			}
+		}
+	}
+	return 1
+}
+
+func (s *markdownScanner) closers() string {
+	var b strings.Builder
+	for j := len(s.open) - 1; j >= 0; j-- {
+		b.WriteString(s.open[j].close)
+	}
+	return b.String()
+}
+
+func (s *markdownScanner) openers() string {
+	var b strings.Builder
+	for _, m := range s.open {
+		b.WriteString(m.open)
+	}
+	return b.String()
+}
+
+// indexRune returns the index of the first c in r at or after from, or -1.
+func indexRune(r []rune, from int, c rune) int {
+	for j := from; j < len(r); j++ {
+		if r[j] == c {
+			return j
+		}
+	}
+	return -1
+}
+
+func hasRunePrefix(r []rune, i int, prefix string) bool {
+	for _, c := range prefix {
+		if i >= len(r) || r[i] != c {
+			return false
+		}
+		i++
+	}
+	return true
+}
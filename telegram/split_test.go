@@ -0,0 +1,150 @@
+package telegram
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitTextWords(t *testing.T) {
+	text := strings.Repeat("word ", 30)
+	chunks := SplitText(text, "", nil, 32)
+	var words int
+	for _, c := range chunks {
+		if UTF16Len(c.Text) > 32 {
+			t.Errorf("chunk too long: %q", c.Text)
+		}
+		for _, w := range strings.Fields(c.Text) {
+			if w != "word" {
+				t.Errorf("broken word %q", w)
+			}
+			words++
+		}
+	}
+	if words != 30 {
+		t.Errorf("got %d words, want 30", words)
+	}
+}
+
+func TestSplitTextEntities(t *testing.T) {
+	text := "hello brave new world"
+	bold := &MessageEntity{Type: EntityTypeBold, Offset: 6, Length: 9} // "brave new"
+	chunks := SplitText(text, "", []*MessageEntity{bold}, 12)
+	if len(chunks) != 2 || chunks[0].Text != "hello brave" || chunks[1].Text != "new world" {
+		t.Fatalf("unexpected chunks %q %q", chunks[0].Text, chunks[len(chunks)-1].Text)
+	}
+	if got := chunks[0].Entities[0].Extract(chunks[0].Text); got != "brave" {
+		t.Errorf("head entity: got %q", got)
+	}
+	if got := chunks[1].Entities[0].Extract(chunks[1].Text); got != "new" {
+		t.Errorf("tail entity: got %q", got)
+	}
+}
+
+func TestSplitTextHTML(t *testing.T) {
+	text := `<b>bold text <i>that goes on</i> and on</b>`
+	chunks := SplitText(text, ParseModeHTML, nil, 30)
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks", len(chunks))
+	}
+	if chunks[0].Text != "<b>bold text <i>that</i></b>" {
+		t.Errorf("head: got %q", chunks[0].Text)
+	}
+	if chunks[1].Text != "<b><i>goes on</i> and on</b>" {
+		t.Errorf("tail: got %q", chunks[1].Text)
+	}
+}
+
+func TestSplitTextMarkdownV2(t *testing.T) {
+	text := "```go\nfmt.Println(1)\nfmt.Println(2)\n```"
+	chunks := SplitText(text, ParseModeMarkdownV2, nil, 30)
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks", len(chunks))
+	}
+	if chunks[0].Text != "```go\nfmt.Println(1)\n```" {
+		t.Errorf("head: got %q", chunks[0].Text)
+	}
+	if chunks[1].Text != "```go\nfmt.Println(2)\n```" {
+		t.Errorf("tail: got %q", chunks[1].Text)
+	}
+}
+
+func TestSplitTextReopenedLink(t *testing.T) {
+	text := "*[" + strings.Repeat("a", 50) + "](http://x)*"
+	done := make(chan []*TextChunk)
+	go func() { done <- SplitText(text, ParseModeMarkdownV2, nil, 20) }()
+	select {
+	case chunks := <-done:
+		var b strings.Builder
+		for _, c := range chunks {
+			if UTF16Len(c.Text) > 20 {
+				t.Errorf("chunk too long: %q", c.Text)
+			}
+			b.WriteString(c.Text)
+		}
+		if !strings.Contains(b.String(), strings.Repeat("a", 50)) {
+			t.Errorf("text lost: %q", b.String())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("SplitText doesn't terminate")
+	}
+}
+
+func TestSplitTextSmallLimit(t *testing.T) {
+	for _, limit := range []int{-1, 0, 1} {
+		done := make(chan []*TextChunk)
+		go func() { done <- SplitText("😀😀", "", nil, limit) }()
+		select {
+		case chunks := <-done:
+			if len(chunks) != 2 || chunks[0].Text != "😀" || chunks[1].Text != "😀" {
+				t.Errorf("limit %d: got %d chunks", limit, len(chunks))
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("limit %d: SplitText doesn't terminate", limit)
+		}
+	}
+}
+
+func TestSendMessageSplit(t *testing.T) {
+	var texts []string
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req MessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		texts = append(texts, req.Text)
+		return &Message{MessageID: int64(len(texts))}
+	})
+	text := strings.Repeat("word ", 1000)
+	message, err := bot.SendMessage(&MessageRequest{ChatID: 1, Text: text, Split: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(texts) != 2 || message.MessageID != 2 {
+		t.Fatalf("got %d messages, last %d", len(texts), message.MessageID)
+	}
+	if got := strings.Join(texts, " "); got != text {
+		t.Errorf("text lost: %d characters sent", len(got))
+	}
+}
+
+func TestSendLongMessageStyled(t *testing.T) {
+	var sent int
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req MessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Entities) == 0 {
+			t.Error("entities of the styled text not sent")
+		}
+		sent++
+		return &Message{MessageID: int64(sent)}
+	})
+	styled := Bold(strings.Repeat("word ", 1000))
+	req := &MessageRequest{ChatID: 1, StyledText: styled}
+	if _, err := bot.SendLongMessage(req); err != nil {
+		t.Fatal(err)
+	}
+	if sent != 2 || req.StyledText != styled || req.Text != "" || req.Entities != nil {
+		t.Errorf("sent %d messages, request changed to %+v", sent, req)
+	}
+}
@@ -0,0 +1,296 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RevenueWithdrawalState describes the state of a revenue withdrawal operation.
+// @docs https://core.telegram.org/bots/api#revenuewithdrawalstate
+type RevenueWithdrawalState struct {
+	Type string `json:"type"` // "pending" | "succeeded" | "failed"
+	// "succeeded" only
+	Date int64  `json:"date,omitempty"`
+	URL  string `json:"url,omitempty"` // An HTTPS URL to see the transaction details
+}
+
+// AffiliateInfo contains information about the affiliate that received a commission via this transaction.
+// @docs https://core.telegram.org/bots/api#affiliateinfo
+type AffiliateInfo struct {
+	AffiliateUser      *User `json:"affiliate_user,omitempty"`
+	AffiliateChat      *Chat `json:"affiliate_chat,omitempty"`
+	CommissionPerMille int   `json:"commission_per_mille"`
+	Amount             int   `json:"amount"` // Integer amount of Telegram Stars received by the affiliate, can be negative for refunds
+	NanostarAmount     int   `json:"nanostar_amount,omitempty"`
+}
+
+// TransactionPartner describes the source of a transaction, or its recipient for outgoing transactions,
+// one of *TransactionPartnerUser, *TransactionPartnerChat, *TransactionPartnerAffiliateProgram,
+// *TransactionPartnerFragment, *TransactionPartnerTelegramAds, *TransactionPartnerTelegramApi
+// or *TransactionPartnerOther.
+// @docs https://core.telegram.org/bots/api#transactionpartner
+type TransactionPartner interface {
+	TransactionPartnerType() string
+}
+
+// TransactionPartnerUser describes a transaction with a user.
+// @docs https://core.telegram.org/bots/api#transactionpartneruser
+type TransactionPartnerUser struct {
+	// "invoice_payment" | "paid_media_payment" | "gift_purchase" | "premium_purchase" | "business_account_transfer"
+	TransactionType string         `json:"transaction_type"`
+	User            *User          `json:"user"`
+	Affiliate       *AffiliateInfo `json:"affiliate,omitempty"`
+	// "invoice_payment" only
+	InvoicePayload     string `json:"invoice_payload,omitempty"`
+	SubscriptionPeriod int    `json:"subscription_period,omitempty"`
+	// "paid_media_payment" only
+	PaidMedia        []*PaidMedia `json:"paid_media,omitempty"`
+	PaidMediaPayload string       `json:"paid_media_payload,omitempty"`
+	// "gift_purchase" only
+	Gift *Gift `json:"gift,omitempty"`
+	// "premium_purchase" only
+	PremiumSubscriptionDuration int `json:"premium_subscription_duration,omitempty"` // Months
+}
+
+// TransactionPartnerChat describes a transaction with a chat.
+// @docs https://core.telegram.org/bots/api#transactionpartnerchat
+type TransactionPartnerChat struct {
+	Chat *Chat `json:"chat"`
+	Gift *Gift `json:"gift,omitempty"` // The gift sent to the chat by the bot
+}
+
+// TransactionPartnerAffiliateProgram describes the affiliate program that issued the
+// affiliate commission received via this transaction.
+// @docs https://core.telegram.org/bots/api#transactionpartneraffiliateprogram
+type TransactionPartnerAffiliateProgram struct {
+	SponsorUser        *User `json:"sponsor_user,omitempty"`
+	CommissionPerMille int   `json:"commission_per_mille"`
+}
+
+// TransactionPartnerFragment describes a withdrawal transaction with Fragment.
+// @docs https://core.telegram.org/bots/api#transactionpartnerfragment
+type TransactionPartnerFragment struct {
+	WithdrawalState *RevenueWithdrawalState `json:"withdrawal_state,omitempty"`
+}
+
+// TransactionPartnerTelegramAds describes a withdrawal transaction to the Telegram Ads platform.
+// @docs https://core.telegram.org/bots/api#transactionpartnertelegramads
+type TransactionPartnerTelegramAds struct{}
+
+// TransactionPartnerTelegramApi describes a transaction with payment for paid broadcasting.
+// @docs https://core.telegram.org/bots/api#transactionpartnertelegramapi
+type TransactionPartnerTelegramApi struct {
+	// The number of successful requests that exceeded regular limits and were therefore billed
+	RequestCount int `json:"request_count"`
+}
+
+// TransactionPartnerOther describes a transaction with an unknown source or recipient.
+// @docs https://core.telegram.org/bots/api#transactionpartnerother
+type TransactionPartnerOther struct{}
+
+func (p *TransactionPartnerUser) TransactionPartnerType() string        { return "user" }
+func (p *TransactionPartnerChat) TransactionPartnerType() string        { return "chat" }
+func (p *TransactionPartnerFragment) TransactionPartnerType() string    { return "fragment" }
+func (p *TransactionPartnerTelegramAds) TransactionPartnerType() string { return "telegram_ads" }
+func (p *TransactionPartnerTelegramApi) TransactionPartnerType() string { return "telegram_api" }
+func (p *TransactionPartnerOther) TransactionPartnerType() string       { return "other" }
+
+func (p *TransactionPartnerAffiliateProgram) TransactionPartnerType() string {
+	return "affiliate_program"
+}
+
+func (p *TransactionPartnerUser) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerUser
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+func (p *TransactionPartnerChat) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerChat
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+func (p *TransactionPartnerAffiliateProgram) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerAffiliateProgram
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+func (p *TransactionPartnerFragment) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerFragment
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+func (p *TransactionPartnerTelegramAds) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerTelegramAds
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+func (p *TransactionPartnerTelegramApi) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerTelegramApi
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+func (p *TransactionPartnerOther) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerOther
+	return marshalUnion(p.TransactionPartnerType(), (*alias)(p))
+}
+
+// UnmarshalTransactionPartner decodes a TransactionPartner according to its "type" field.
+func UnmarshalTransactionPartner(data []byte) (partner TransactionPartner, err error) {
+	var head struct {
+		Type string `json:"type"`
+	}
+	if err = json.Unmarshal(data, &head); err != nil {
+		return
+	}
+	switch head.Type {
+	case "user":
+		partner = &TransactionPartnerUser{}
+	case "chat":
+		partner = &TransactionPartnerChat{}
+	case "affiliate_program":
+		partner = &TransactionPartnerAffiliateProgram{}
+	case "fragment":
+		partner = &TransactionPartnerFragment{}
+	case "telegram_ads":
+		partner = &TransactionPartnerTelegramAds{}
+	case "telegram_api":
+		partner = &TransactionPartnerTelegramApi{}
+	case "other":
+		partner = &TransactionPartnerOther{}
+	default:
+		return nil, fmt.Errorf("telegram: unknown transaction partner type %q", head.Type)
+	}
+	err = json.Unmarshal(data, partner)
+	return
+}
+
+// StarTransaction describes a Telegram Star transaction.
+// @docs https://core.telegram.org/bots/api#startransaction
+type StarTransaction struct {
+	// Unique identifier of the transaction, coinciding with the identifier of the original
+	// transaction for refund transactions; SuccessfulPayment.TelegramPaymentChargeID for successful incoming payments
+	ID             string             `json:"id"`
+	Amount         int                `json:"amount"`
+	NanostarAmount int                `json:"nanostar_amount,omitempty"` // 0-999999999
+	Date           int64              `json:"date"`
+	Source         TransactionPartner `json:"source,omitempty"`   // Incoming transactions only
+	Receiver       TransactionPartner `json:"receiver,omitempty"` // Outgoing transactions only
+}
+
+// UnmarshalJSON decodes the source and receiver of the transaction according to their type.
+func (t *StarTransaction) UnmarshalJSON(data []byte) (err error) {
+	type alias StarTransaction
+	var v struct {
+		*alias
+		Source   json.RawMessage `json:"source"`
+		Receiver json.RawMessage `json:"receiver"`
+	}
+	v.alias = (*alias)(t)
+	if err = json.Unmarshal(data, &v); err != nil {
+		return
+	}
+	if len(v.Source) > 0 {
+		if t.Source, err = UnmarshalTransactionPartner(v.Source); err != nil {
+			return
+		}
+	}
+	if len(v.Receiver) > 0 {
+		t.Receiver, err = UnmarshalTransactionPartner(v.Receiver)
+	}
+	return
+}
+
+// IsIncoming reports whether the bot received the transaction.
+func (t *StarTransaction) IsIncoming() bool {
+	return t.Source != nil
+}
+
+// StarTransactions contains a list of Telegram Star transactions.
+// @docs https://core.telegram.org/bots/api#startransactions
+type StarTransactions struct {
+	Transactions []*StarTransaction `json:"transactions"`
+}
+
+type StarTransactionsRequest struct {
+	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty"` // 1-100, defaults to 100
+}
+
+// GetStarTransactions returns the bot's Telegram Star transactions in chronological order,
+// skipping the first offset ones.
+// https://core.telegram.org/bots/api#getstartransactions
+func (bot *TelegramBot) GetStarTransactions(offset, limit int) (result *StarTransactions, err error) {
+	err = bot.CallMethod("getStarTransactions", &StarTransactionsRequest{Offset: offset, Limit: limit}, &result)
+	return
+}
+
+// EachStarTransaction calls fn with every transaction of the bot from offset on,
+// fetching them page by page, until fn returns an error.
+func (bot *TelegramBot) EachStarTransaction(offset int, fn func(transaction *StarTransaction) error) error {
+	const pageSize = 100
+	for {
+		page, err := bot.GetStarTransactions(offset, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, transaction := range page.Transactions {
+			if err := fn(transaction); err != nil {
+				return err
+			}
+		}
+		if len(page.Transactions) < pageSize {
+			return nil
+		}
+		offset += len(page.Transactions)
+	}
+}
+
+type RefundStarPaymentRequest struct {
+	UserID                  int64  `json:"user_id"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+}
+
+// RefundStarPayment refunds a successful payment in Telegram Stars.
+// https://core.telegram.org/bots/api#refundstarpayment
+func (bot *TelegramBot) RefundStarPayment(userID int64, telegramPaymentChargeID string) error {
+	return bot.CallMethod("refundStarPayment", &RefundStarPaymentRequest{UserID: userID, TelegramPaymentChargeID: telegramPaymentChargeID}, nil)
+}
+
+type EditUserStarSubscriptionRequest struct {
+	UserID                  int64  `json:"user_id"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	// Cancel the extension of the subscription, or re-enable it if it was canceled by the bot.
+	// The subscription stays active until the end of the current period.
+	IsCanceled bool `json:"is_canceled"`
+}
+
+// EditUserStarSubscription cancels or re-enables the extension of a subscription paid in Telegram Stars.
+// https://core.telegram.org/bots/api#edituserstarsubscription
+func (bot *TelegramBot) EditUserStarSubscription(userID int64, telegramPaymentChargeID string, isCanceled bool) error {
+	return bot.CallMethod("editUserStarSubscription", &EditUserStarSubscriptionRequest{
+		UserID:                  userID,
+		TelegramPaymentChargeID: telegramPaymentChargeID,
+		IsCanceled:              isCanceled,
+	}, nil)
+}
+
+// StarAmount describes an amount of Telegram Stars.
+// @docs https://core.telegram.org/bots/api#staramount
+type StarAmount struct {
+	Amount int `json:"amount"` // Integer amount of Telegram Stars, rounded to 0; can be negative
+	// The number of 1/1000000000 shares of Telegram Stars; from -999999999 to 999999999,
+	// can be negative if and only if Amount is non-positive
+	NanostarAmount int `json:"nanostar_amount,omitempty"`
+}
+
+// Nanostars returns the amount in 1/1000000000 shares of Telegram Stars.
+func (a *StarAmount) Nanostars() int64 {
+	return int64(a.Amount)*1e9 + int64(a.NanostarAmount)
+}
+
+// GetMyStarBalance returns the current Telegram Stars balance of the bot.
+// https://core.telegram.org/bots/api#getmystarbalance
+func (bot *TelegramBot) GetMyStarBalance() (balance *StarAmount, err error) {
+	err = bot.CallMethod("getMyStarBalance", nil, &balance)
+	return
+}
@@ -0,0 +1,96 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// StatusMessages keeps a single bot message per chat up to date, such as a
+// live dashboard, remembering its message_id in a Store so that it survives
+// restarts:
+//
+//	status := telegram.NewStatusMessages(bot, telegram.NewMemoryStore())
+//	status.Update(&telegram.MessageRequest{ChatID: chatID, Text: report()})
+type StatusMessages struct {
+	bot   *TelegramBot
+	store Store
+}
+
+// NewStatusMessages returns StatusMessages storing message identifiers in store.
+func NewStatusMessages(bot *TelegramBot, store Store) *StatusMessages {
+	return &StatusMessages{bot: bot, store: store}
+}
+
+func statusMessageKey(chatID any, threadID int64) string {
+	return fmt.Sprintf("status:%v:%d", chatID, threadID)
+}
+
+// MessageID returns the identifier of the status message of a chat, 0 if none.
+func (s *StatusMessages) MessageID(chatID any, threadID int64) (int64, error) {
+	value, ok, err := s.store.Get(statusMessageKey(chatID, threadID))
+	if err != nil || !ok {
+		return 0, err
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+// Update edits the status message of the chat and thread of req with its
+// text, or sends it as a new status message if there is none yet, or if the
+// previous one was deleted or can't be edited anymore.
+// ReplyMarkup must be an *InlineKeyboardMarkup, as other markups can't be edited.
+// If the message already has this content, Update returns a nil message and no error.
+func (s *StatusMessages) Update(req *MessageRequest) (message *Message, err error) {
+	key := statusMessageKey(req.ChatID, req.MessageThreadID)
+	messageID, err := s.MessageID(req.ChatID, req.MessageThreadID)
+	if err != nil {
+		return
+	}
+	// Work on a copy, sent as is if the edit falls back to a new message
+	req = s.bot.prepareMessage(req)
+	if messageID != 0 {
+		keyboard, _ := req.ReplyMarkup.(*InlineKeyboardMarkup)
+		message, err = s.bot.EditMessageText(&EditMessageTextRequest{
+			BusinessConnectionID: req.BusinessConnectionID,
+			ChatID:               req.ChatID,
+			MessageID:            messageID,
+			Text:                 req.Text,
+			ParseMode:            req.ParseMode,
+			Entities:             req.Entities,
+			LinkPreviewOptions:   req.LinkPreviewOptions,
+			ReplyMarkup:          keyboard,
+		})
+		switch {
+		case errors.Is(err, ErrMessageNotModified):
+			return nil, nil
+		case errors.Is(err, ErrMessageNotFound), errors.Is(err, ErrMessageCantBeEdited):
+			// Send a new status message below
+		default:
+			return
+		}
+	}
+	message, err = s.bot.SendMessage(req)
+	if err != nil {
+		return
+	}
+	err = s.store.Set(key, []byte(strconv.FormatInt(message.MessageID, 10)))
+	return
+}
+
+// Forget makes the next Update of the chat send a new status message,
+// leaving the current one as is.
+func (s *StatusMessages) Forget(chatID any, threadID int64) error {
+	return s.store.Delete(statusMessageKey(chatID, threadID))
+}
+
+// Delete deletes the status message of the chat, if any.
+func (s *StatusMessages) Delete(chatID any, threadID int64) error {
+	messageID, err := s.MessageID(chatID, threadID)
+	if err != nil || messageID == 0 {
+		return err
+	}
+	if err = s.bot.DeleteMessage(chatID, messageID); err != nil {
+		return err
+	}
+	return s.Forget(chatID, threadID)
+}
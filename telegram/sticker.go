@@ -0,0 +1,206 @@
+package telegram
+
+// Sticker types, see Sticker.Type and StickerSet.StickerType.
+const (
+	StickerTypeRegular     = "regular"
+	StickerTypeMask        = "mask"
+	StickerTypeCustomEmoji = "custom_emoji"
+)
+
+// Sticker formats, see InputSticker.Format.
+const (
+	StickerFormatStatic   = "static"   // .WEBP or .PNG image
+	StickerFormatAnimated = "animated" // .TGS animation
+	StickerFormatVideo    = "video"    // .WEBM video
+)
+
+// StickerSet represents a sticker set.
+// @docs https://core.telegram.org/bots/api#stickerset
+type StickerSet struct {
+	Name        string     `json:"name"`
+	Title       string     `json:"title"`
+	StickerType string     `json:"sticker_type"` // "regular" | "mask" | "custom_emoji"
+	Stickers    []*Sticker `json:"stickers"`
+	Thumbnail   *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// InputSticker describes a sticker to be added to a sticker set.
+// @docs https://core.telegram.org/bots/api#inputsticker
+type InputSticker struct {
+	// Animated and video stickers can't be uploaded via HTTP URL.
+	Sticker      *InputFile    `json:"sticker"`
+	Format       string        `json:"format"`                  // "static" | "animated" | "video"
+	EmojiList    []string      `json:"emoji_list"`              // 1-20 emoji
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // "mask" stickers only
+	Keywords     []string      `json:"keywords,omitempty"`      // 0-20 search keywords, "regular" and "custom_emoji" stickers only
+}
+
+type StickerSetRequest struct {
+	Name string `json:"name"`
+}
+
+// GetStickerSet returns a sticker set.
+// https://core.telegram.org/bots/api#getstickerset
+func (bot *TelegramBot) GetStickerSet(name string) (set *StickerSet, err error) {
+	err = bot.CallMethod("getStickerSet", &StickerSetRequest{Name: name}, &set)
+	return
+}
+
+type UploadStickerFileRequest struct {
+	UserID        int64      `json:"user_id"` // Owner of the sticker set
+	Sticker       *InputFile `json:"sticker"`
+	StickerFormat string     `json:"sticker_format"`
+}
+
+// UploadStickerFile uploads a file with a sticker for later use in the
+// CreateNewStickerSet, AddStickerToSet and ReplaceStickerInSet methods.
+// https://core.telegram.org/bots/api#uploadstickerfile
+func (bot *TelegramBot) UploadStickerFile(userID int64, sticker *InputFile, format string) (file *File, err error) {
+	err = bot.CallMethod("uploadStickerFile", &UploadStickerFileRequest{UserID: userID, Sticker: sticker, StickerFormat: format}, &file)
+	return
+}
+
+type CreateNewStickerSetRequest struct {
+	UserID int64 `json:"user_id"` // Owner of the created sticker set
+	// Short name of the set, used in t.me/addstickers/ URLs; it must end with "_by_<bot_username>"
+	Name            string          `json:"name"`
+	Title           string          `json:"title"`    // 1-64 characters
+	Stickers        []*InputSticker `json:"stickers"` // 1-50 initial stickers
+	StickerType     string          `json:"sticker_type,omitempty"`
+	NeedsRepainting bool            `json:"needs_repainting,omitempty"` // "custom_emoji" sets only
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user.
+// https://core.telegram.org/bots/api#createnewstickerset
+func (bot *TelegramBot) CreateNewStickerSet(req *CreateNewStickerSetRequest) error {
+	return bot.CallMethod("createNewStickerSet", req, nil)
+}
+
+type AddStickerToSetRequest struct {
+	UserID  int64         `json:"user_id"`
+	Name    string        `json:"name"`
+	Sticker *InputSticker `json:"sticker"`
+}
+
+// AddStickerToSet adds a new sticker to a set created by the bot.
+// Emoji sticker sets can have up to 200 stickers, other sticker sets up to 120.
+// https://core.telegram.org/bots/api#addstickertoset
+func (bot *TelegramBot) AddStickerToSet(userID int64, name string, sticker *InputSticker) error {
+	return bot.CallMethod("addStickerToSet", &AddStickerToSetRequest{UserID: userID, Name: name, Sticker: sticker}, nil)
+}
+
+type StickerPositionRequest struct {
+	Sticker  string `json:"sticker"` // File identifier of the sticker
+	Position int    `json:"position"`
+}
+
+// SetStickerPositionInSet moves a sticker in a set created by the bot to a specific zero-based position.
+// https://core.telegram.org/bots/api#setstickerpositioninset
+func (bot *TelegramBot) SetStickerPositionInSet(sticker string, position int) error {
+	return bot.CallMethod("setStickerPositionInSet", &StickerPositionRequest{Sticker: sticker, Position: position}, nil)
+}
+
+type StickerFileRequest struct {
+	Sticker string `json:"sticker"` // File identifier of the sticker
+}
+
+// DeleteStickerFromSet deletes a sticker from a set created by the bot.
+// https://core.telegram.org/bots/api#deletestickerfromset
+func (bot *TelegramBot) DeleteStickerFromSet(sticker string) error {
+	return bot.CallMethod("deleteStickerFromSet", &StickerFileRequest{Sticker: sticker}, nil)
+}
+
+type ReplaceStickerInSetRequest struct {
+	UserID     int64         `json:"user_id"`
+	Name       string        `json:"name"`
+	OldSticker string        `json:"old_sticker"` // File identifier of the replaced sticker
+	Sticker    *InputSticker `json:"sticker"`
+}
+
+// ReplaceStickerInSet replaces an existing sticker in a sticker set with a new one,
+// which is equivalent to deleting it, adding the new one and moving it to its position.
+// https://core.telegram.org/bots/api#replacestickerinset
+func (bot *TelegramBot) ReplaceStickerInSet(req *ReplaceStickerInSetRequest) error {
+	return bot.CallMethod("replaceStickerInSet", req, nil)
+}
+
+type StickerEmojiListRequest struct {
+	Sticker   string   `json:"sticker"`
+	EmojiList []string `json:"emoji_list"` // 1-20 emoji
+}
+
+// SetStickerEmojiList changes the list of emoji assigned to a regular or custom emoji sticker
+// of a set created by the bot.
+// https://core.telegram.org/bots/api#setstickeremojilist
+func (bot *TelegramBot) SetStickerEmojiList(sticker string, emojiList []string) error {
+	return bot.CallMethod("setStickerEmojiList", &StickerEmojiListRequest{Sticker: sticker, EmojiList: emojiList}, nil)
+}
+
+type StickerKeywordsRequest struct {
+	Sticker  string   `json:"sticker"`
+	Keywords []string `json:"keywords"` // 0-20 keywords, 64 characters in total
+}
+
+// SetStickerKeywords changes the search keywords assigned to a regular or custom emoji sticker
+// of a set created by the bot.
+// https://core.telegram.org/bots/api#setstickerkeywords
+func (bot *TelegramBot) SetStickerKeywords(sticker string, keywords []string) error {
+	return bot.CallMethod("setStickerKeywords", &StickerKeywordsRequest{Sticker: sticker, Keywords: keywords}, nil)
+}
+
+type StickerMaskPositionRequest struct {
+	Sticker      string        `json:"sticker"`
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // Omit to remove the mask position
+}
+
+// SetStickerMaskPosition changes the mask position of a mask sticker of a set created by the bot.
+// https://core.telegram.org/bots/api#setstickermaskposition
+func (bot *TelegramBot) SetStickerMaskPosition(sticker string, position *MaskPosition) error {
+	return bot.CallMethod("setStickerMaskPosition", &StickerMaskPositionRequest{Sticker: sticker, MaskPosition: position}, nil)
+}
+
+type StickerSetTitleRequest struct {
+	Name  string `json:"name"`
+	Title string `json:"title"` // 1-64 characters
+}
+
+// SetStickerSetTitle sets the title of a sticker set created by the bot.
+// https://core.telegram.org/bots/api#setstickersettitle
+func (bot *TelegramBot) SetStickerSetTitle(name, title string) error {
+	return bot.CallMethod("setStickerSetTitle", &StickerSetTitleRequest{Name: name, Title: title}, nil)
+}
+
+type StickerSetThumbnailRequest struct {
+	Name   string `json:"name"`
+	UserID int64  `json:"user_id"` // Owner of the sticker set
+	// A .WEBP or .PNG image of 100x100 pixels up to 128 kilobytes, a .TGS animation up to 32 kilobytes
+	// or a .WEBM video up to 32 kilobytes; omit to drop the thumbnail and use the first sticker instead.
+	// Animated and video thumbnails can't be uploaded via HTTP URL.
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	Format    string     `json:"format"` // "static" | "animated" | "video"
+}
+
+// SetStickerSetThumbnail sets the thumbnail of a regular or mask sticker set,
+// which must match the format of the stickers in the set.
+// https://core.telegram.org/bots/api#setstickersetthumbnail
+func (bot *TelegramBot) SetStickerSetThumbnail(req *StickerSetThumbnailRequest) error {
+	return bot.CallMethod("setStickerSetThumbnail", req, nil)
+}
+
+type CustomEmojiStickerSetThumbnailRequest struct {
+	Name          string `json:"name"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"` // Empty to use the first sticker as the thumbnail
+}
+
+// SetCustomEmojiStickerSetThumbnail sets the thumbnail of a custom emoji sticker set
+// to one of its stickers.
+// https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+func (bot *TelegramBot) SetCustomEmojiStickerSetThumbnail(name, customEmojiID string) error {
+	return bot.CallMethod("setCustomEmojiStickerSetThumbnail", &CustomEmojiStickerSetThumbnailRequest{Name: name, CustomEmojiID: customEmojiID}, nil)
+}
+
+// DeleteStickerSet deletes a sticker set that was created by the bot.
+// https://core.telegram.org/bots/api#deletestickerset
+func (bot *TelegramBot) DeleteStickerSet(name string) error {
+	return bot.CallMethod("deleteStickerSet", &StickerSetRequest{Name: name}, nil)
+}
@@ -0,0 +1,47 @@
+package telegram
+
+import "sync"
+
+// Store is a pluggable key-value store used by the bot to persist state,
+// such as the upload cache. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the value stored under key, ok is false if there is none.
+	Get(key string) (value []byte, ok bool, err error)
+	Set(key string, value []byte) error
+	Delete(key string) error
+}
+
+// MemoryStore is a Store keeping its values in memory.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	values map[string][]byte
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: make(map[string][]byte)}
+}
+
+func (s *MemoryStore) Get(key string) (value []byte, ok bool, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok = s.values[key]
+	return
+}
+
+func (s *MemoryStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values == nil {
+		s.values = make(map[string][]byte)
+	}
+	s.values[key] = value
+	return nil
+}
+
+func (s *MemoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
@@ -0,0 +1,237 @@
+package telegram
+
+import "fmt"
+
+// Story active periods accepted by PostStory.
+const (
+	StoryActive6Hours  = 6 * 3600
+	StoryActive12Hours = 12 * 3600
+	StoryActive1Day    = 86400
+	StoryActive2Days   = 2 * 86400
+)
+
+// InputStoryContent describes the content of a story to post,
+// one of InputStoryContentPhoto or InputStoryContentVideo.
+// The content must be uploaded, it is sent using the attach:// scheme automatically.
+// @docs https://core.telegram.org/bots/api#inputstorycontent
+type InputStoryContent interface {
+	StoryContentType() string
+}
+
+// InputStoryContentPhoto is a photo to post as a story, of 1080x1920 pixels.
+// @docs https://core.telegram.org/bots/api#inputstorycontentphoto
+type InputStoryContentPhoto struct {
+	Photo *InputFile `json:"photo"`
+}
+
+// InputStoryContentVideo is a video to post as a story, of 720x1280 pixels, streamable,
+// encoded with H.265 and at most 60 seconds long.
+// @docs https://core.telegram.org/bots/api#inputstorycontentvideo
+type InputStoryContentVideo struct {
+	Video               *InputFile `json:"video"`
+	Duration            float64    `json:"duration,omitempty"`              // 0-60 seconds
+	CoverFrameTimestamp float64    `json:"cover_frame_timestamp,omitempty"` // Seconds of the frame used as cover
+	IsAnimation         bool       `json:"is_animation,omitempty"`          // The video has no sound
+}
+
+func (c *InputStoryContentPhoto) StoryContentType() string { return "photo" }
+func (c *InputStoryContentVideo) StoryContentType() string { return "video" }
+
+func (c *InputStoryContentPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputStoryContentPhoto
+	return marshalUnion(c.StoryContentType(), (*alias)(c))
+}
+
+func (c *InputStoryContentVideo) MarshalJSON() ([]byte, error) {
+	type alias InputStoryContentVideo
+	return marshalUnion(c.StoryContentType(), (*alias)(c))
+}
+
+// StoryAreaPosition describes the position of a clickable area within a story,
+// in percentages of the media width and height.
+// @docs https://core.telegram.org/bots/api#storyareaposition
+type StoryAreaPosition struct {
+	XPercentage            float64 `json:"x_percentage"` // Of the center of the area
+	YPercentage            float64 `json:"y_percentage"`
+	WidthPercentage        float64 `json:"width_percentage"`
+	HeightPercentage       float64 `json:"height_percentage"`
+	RotationAngle          float64 `json:"rotation_angle"` // Clockwise, in degrees
+	CornerRadiusPercentage float64 `json:"corner_radius_percentage"`
+}
+
+// StoryArea describes a clickable area on a story media.
+// @docs https://core.telegram.org/bots/api#storyarea
+type StoryArea struct {
+	Position *StoryAreaPosition `json:"position"`
+	Type     StoryAreaType      `json:"type"`
+}
+
+// StoryAreaType describes the type of a clickable area on a story, one of
+// StoryAreaTypeLocation, StoryAreaTypeSuggestedReaction, StoryAreaTypeLink,
+// StoryAreaTypeWeather or StoryAreaTypeUniqueGift.
+// @docs https://core.telegram.org/bots/api#storyareatype
+type StoryAreaType interface {
+	StoryAreaType() string
+}
+
+// LocationAddress describes the physical address of a location.
+// @docs https://core.telegram.org/bots/api#locationaddress
+type LocationAddress struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2
+	State       string `json:"state,omitempty"`
+	City        string `json:"city,omitempty"`
+	Street      string `json:"street,omitempty"`
+}
+
+// StoryAreaTypeLocation shows a location. Stories can have up to 10 location areas.
+// @docs https://core.telegram.org/bots/api#storyareatypelocation
+type StoryAreaTypeLocation struct {
+	Latitude  float64          `json:"latitude"`
+	Longitude float64          `json:"longitude"`
+	Address   *LocationAddress `json:"address,omitempty"`
+}
+
+// StoryAreaTypeSuggestedReaction shows a reaction. Stories can have up to 5 suggested reaction areas.
+// @docs https://core.telegram.org/bots/api#storyareatypesuggestedreaction
+type StoryAreaTypeSuggestedReaction struct {
+	ReactionType *ReactionType `json:"reaction_type"`
+	IsDark       bool          `json:"is_dark,omitempty"`
+	IsFlipped    bool          `json:"is_flipped,omitempty"`
+}
+
+// StoryAreaTypeLink shows a link. Stories can have up to 3 link areas.
+// @docs https://core.telegram.org/bots/api#storyareatypelink
+type StoryAreaTypeLink struct {
+	URL string `json:"url"`
+}
+
+// StoryAreaTypeWeather shows the weather. Stories can have up to 3 weather areas.
+// @docs https://core.telegram.org/bots/api#storyareatypeweather
+type StoryAreaTypeWeather struct {
+	Temperature     float64 `json:"temperature"` // Celsius
+	Emoji           string  `json:"emoji"`
+	BackgroundColor int     `json:"background_color"` // ARGB
+}
+
+// StoryAreaTypeUniqueGift shows a unique gift. Stories can have at most 1 unique gift area.
+// @docs https://core.telegram.org/bots/api#storyareatypeuniquegift
+type StoryAreaTypeUniqueGift struct {
+	Name string `json:"name"`
+}
+
+func (a *StoryAreaTypeLocation) StoryAreaType() string          { return "location" }
+func (a *StoryAreaTypeSuggestedReaction) StoryAreaType() string { return "suggested_reaction" }
+func (a *StoryAreaTypeLink) StoryAreaType() string              { return "link" }
+func (a *StoryAreaTypeWeather) StoryAreaType() string           { return "weather" }
+func (a *StoryAreaTypeUniqueGift) StoryAreaType() string        { return "unique_gift" }
+
+func (a *StoryAreaTypeLocation) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeLocation
+	return marshalUnion(a.StoryAreaType(), (*alias)(a))
+}
+
+func (a *StoryAreaTypeSuggestedReaction) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeSuggestedReaction
+	return marshalUnion(a.StoryAreaType(), (*alias)(a))
+}
+
+func (a *StoryAreaTypeLink) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeLink
+	return marshalUnion(a.StoryAreaType(), (*alias)(a))
+}
+
+func (a *StoryAreaTypeWeather) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeWeather
+	return marshalUnion(a.StoryAreaType(), (*alias)(a))
+}
+
+func (a *StoryAreaTypeUniqueGift) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeUniqueGift
+	return marshalUnion(a.StoryAreaType(), (*alias)(a))
+}
+
+// validateStoryContent checks that the content of a story is uploaded,
+// as stories can't be posted from file IDs or URLs.
+func validateStoryContent(content InputStoryContent) error {
+	var file *InputFile
+	switch c := content.(type) {
+	case *InputStoryContentPhoto:
+		if c != nil {
+			file = c.Photo
+		}
+	case *InputStoryContentVideo:
+		if c != nil {
+			file = c.Video
+		}
+	case nil:
+	default:
+		// Other implementations are left to the API
+		return nil
+	}
+	if file == nil {
+		return fmt.Errorf("telegram: story content is required")
+	}
+	if !file.IsUpload() {
+		return fmt.Errorf("telegram: story %s must be uploaded", content.StoryContentType())
+	}
+	return nil
+}
+
+type PostStoryRequest struct {
+	BusinessConnectionID string            `json:"business_connection_id"`
+	Content              InputStoryContent `json:"content"`
+	ActivePeriod         int               `json:"active_period"` // One of the StoryActive* periods, in seconds
+	Caption              string            `json:"caption,omitempty"`
+	ParseMode            ParseMode         `json:"parse_mode,omitempty"`
+	CaptionEntities      []*MessageEntity  `json:"caption_entities,omitempty"`
+	Areas                []*StoryArea      `json:"areas,omitempty"`
+	PostToChatPage       bool              `json:"post_to_chat_page,omitempty"` // Keep the story on the profile page after it expires
+	ProtectContent       bool              `json:"protect_content,omitempty"`
+}
+
+// PostStory posts a story on behalf of a managed business account.
+// Requires the CanManageStories business bot right.
+// https://core.telegram.org/bots/api#poststory
+func (bot *TelegramBot) PostStory(req *PostStoryRequest) (story *Story, err error) {
+	if err = validateStoryContent(req.Content); err != nil {
+		return
+	}
+	err = bot.CallMethod("postStory", req, &story)
+	return
+}
+
+type EditStoryRequest struct {
+	BusinessConnectionID string            `json:"business_connection_id"`
+	StoryID              int64             `json:"story_id"`
+	Content              InputStoryContent `json:"content"`
+	Caption              string            `json:"caption,omitempty"`
+	ParseMode            ParseMode         `json:"parse_mode,omitempty"`
+	CaptionEntities      []*MessageEntity  `json:"caption_entities,omitempty"`
+	Areas                []*StoryArea      `json:"areas,omitempty"`
+}
+
+// EditStory edits a story previously posted by the bot on behalf of a managed business account.
+// Requires the CanManageStories business bot right.
+// https://core.telegram.org/bots/api#editstory
+func (bot *TelegramBot) EditStory(req *EditStoryRequest) (story *Story, err error) {
+	if err = validateStoryContent(req.Content); err != nil {
+		return
+	}
+	err = bot.CallMethod("editStory", req, &story)
+	return
+}
+
+type DeleteStoryRequest struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+	StoryID              int64  `json:"story_id"`
+}
+
+// DeleteStory deletes a story previously posted by the bot on behalf of a managed business account.
+// Requires the CanManageStories business bot right.
+// https://core.telegram.org/bots/api#deletestory
+func (bot *TelegramBot) DeleteStory(businessConnectionID string, storyID int64) error {
+	return bot.CallMethod("deleteStory", &DeleteStoryRequest{
+		BusinessConnectionID: businessConnectionID,
+		StoryID:              storyID,
+	}, nil)
+}
@@ -0,0 +1,109 @@
+package telegram
+
+import "fmt"
+
+// StyledText is a text along with its formatting entities, built with Text,
+// Bold, Link, etc. Sent with entities, it needs no parse mode nor escaping:
+//
+//	telegram.Text(telegram.Bold("Alert: "), telegram.Code(host), " is down, see ", telegram.Link("docs", url))
+type StyledText struct {
+	Text     string
+	Entities []*MessageEntity
+}
+
+// Text concatenates parts into a StyledText. Parts are strings, *StyledText
+// or any other value formatted with fmt.Sprint.
+func Text(parts ...any) *StyledText {
+	s := &StyledText{}
+	for _, part := range parts {
+		s.Append(part)
+	}
+	return s
+}
+
+// Append adds part at the end of s, see Text.
+func (s *StyledText) Append(part any) *StyledText {
+	offset := UTF16Len(s.Text)
+	switch p := part.(type) {
+	case *StyledText:
+		s.Text += p.Text
+		for _, e := range p.Entities {
+			shifted := *e
+			shifted.Offset += offset
+			s.Entities = append(s.Entities, &shifted)
+		}
+	case string:
+		s.Text += p
+	default:
+		s.Text += fmt.Sprint(p)
+	}
+	return s
+}
+
+// String returns the plain text.
+func (s *StyledText) String() string {
+	return s.Text
+}
+
+// styled wraps parts in an entity of the given type covering all of them.
+func styled(entity *MessageEntity, parts []any) *StyledText {
+	inner := Text(parts...)
+	entity.Length = UTF16Len(inner.Text)
+	if entity.Length == 0 {
+		return inner
+	}
+	return &StyledText{Text: inner.Text, Entities: append([]*MessageEntity{entity}, inner.Entities...)}
+}
+
+func Bold(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeBold}, parts)
+}
+
+func Italic(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeItalic}, parts)
+}
+
+func Underline(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeUnderline}, parts)
+}
+
+func Strikethrough(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeStrikethrough}, parts)
+}
+
+func Spoiler(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeSpoiler}, parts)
+}
+
+func Blockquote(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeBlockquote}, parts)
+}
+
+func ExpandableBlockquote(parts ...any) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeExpandableBlockquote}, parts)
+}
+
+// Code formats text as inline monospace code.
+func Code(text string) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeCode}, []any{text})
+}
+
+// Pre formats text as a code block, language may be empty.
+func Pre(text, language string) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypePre, Language: language}, []any{text})
+}
+
+// Link makes text a link to url.
+func Link(text any, url string) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeTextLink, URL: url}, []any{text})
+}
+
+// Mention makes text a mention of user, for users without a username.
+func Mention(text any, user *User) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeTextMention, User: user}, []any{text})
+}
+
+// CustomEmoji displays a custom emoji, emoji is shown where custom emoji are not supported.
+func CustomEmoji(emoji, customEmojiID string) *StyledText {
+	return styled(&MessageEntity{Type: EntityTypeCustomEmoji, CustomEmojiID: customEmojiID}, []any{emoji})
+}
@@ -0,0 +1,28 @@
+package telegram
+
+import "testing"
+
+func TestStyledText(t *testing.T) {
+	s := Text(Bold("Alert: ", Italic("🔥")), Code("db-1"), " see ", Link("docs", "https://example.com"))
+	if s.Text != "Alert: 🔥db-1 see docs" {
+		t.Fatalf("text: got %q", s.Text)
+	}
+	want := []struct {
+		typ  EntityType
+		text string
+	}{
+		{EntityTypeBold, "Alert: 🔥"},
+		{EntityTypeItalic, "🔥"},
+		{EntityTypeCode, "db-1"},
+		{EntityTypeTextLink, "docs"},
+	}
+	if len(s.Entities) != len(want) {
+		t.Fatalf("got %d entities", len(s.Entities))
+	}
+	for i, w := range want {
+		e := s.Entities[i]
+		if e.Type != w.typ || e.Extract(s.Text) != w.text {
+			t.Errorf("entity %d: got %s %q", i, e.Type, e.Extract(s.Text))
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package telegram
+
+// DirectMessagesTopic describes a topic of a direct messages chat,
+// the chat in which users write to a channel.
+// @docs https://core.telegram.org/bots/api#directmessagestopic
+type DirectMessagesTopic struct {
+	TopicID int64 `json:"topic_id"`
+	User    *User `json:"user,omitempty"` // The user that created the topic
+}
+
+// SuggestedPostPrice describes the price of a suggested post.
+// @docs https://core.telegram.org/bots/api#suggestedpostprice
+type SuggestedPostPrice struct {
+	Currency string `json:"currency"` // "XTR" for Telegram Stars or "TON" for toncoins
+	// In the smallest units of the currency: 5-100000 Stars, or 10000000-10000000000000 nanotoncoins
+	Amount int64 `json:"amount"`
+}
+
+// SuggestedPostParameters describes the parameters of a post suggested by the bot.
+// @docs https://core.telegram.org/bots/api#suggestedpostparameters
+type SuggestedPostParameters struct {
+	Price *SuggestedPostPrice `json:"price,omitempty"` // Nil for an unpaid post
+	// Unix time when the post is expected to be published, 300 seconds to 30 days in the future.
+	// If zero, the post is published by the channel administrator who approves it.
+	SendDate int64 `json:"send_date,omitempty"`
+}
+
+// SuggestedPostInfo contains information about a suggested post.
+// @docs https://core.telegram.org/bots/api#suggestedpostinfo
+type SuggestedPostInfo struct {
+	State    string              `json:"state"` // "pending" | "approved" | "declined"
+	Price    *SuggestedPostPrice `json:"price,omitempty"`
+	SendDate int64               `json:"send_date,omitempty"`
+}
+
+// SuggestedPostApproved describes a service message about the approval of a suggested post.
+// @docs https://core.telegram.org/bots/api#suggestedpostapproved
+type SuggestedPostApproved struct {
+	SuggestedPostMessage *Message            `json:"suggested_post_message,omitempty"`
+	Price                *SuggestedPostPrice `json:"price,omitempty"`
+	SendDate             int64               `json:"send_date"`
+}
+
+// SuggestedPostApprovalFailed describes a service message about the failed approval of a suggested post,
+// because the user who sent it didn't have enough funds.
+// @docs https://core.telegram.org/bots/api#suggestedpostapprovalfailed
+type SuggestedPostApprovalFailed struct {
+	SuggestedPostMessage *Message            `json:"suggested_post_message,omitempty"`
+	Price                *SuggestedPostPrice `json:"price"`
+}
+
+// SuggestedPostDeclined describes a service message about the rejection of a suggested post.
+// @docs https://core.telegram.org/bots/api#suggestedpostdeclined
+type SuggestedPostDeclined struct {
+	SuggestedPostMessage *Message `json:"suggested_post_message,omitempty"`
+	Comment              string   `json:"comment,omitempty"`
+}
+
+// SuggestedPostPaid describes a service message about a successful payment for a suggested post.
+// @docs https://core.telegram.org/bots/api#suggestedpostpaid
+type SuggestedPostPaid struct {
+	SuggestedPostMessage *Message    `json:"suggested_post_message,omitempty"`
+	Currency             string      `json:"currency"`
+	Amount               int64       `json:"amount,omitempty"`      // "TON" only, in nanotoncoins
+	StarAmount           *StarAmount `json:"star_amount,omitempty"` // "XTR" only
+}
+
+// SuggestedPostRefunded describes a service message about a payment refund for a suggested post.
+// @docs https://core.telegram.org/bots/api#suggestedpostrefunded
+type SuggestedPostRefunded struct {
+	SuggestedPostMessage *Message `json:"suggested_post_message,omitempty"`
+	Reason               string   `json:"reason"` // "post_deleted" | "payment_refunded"
+}
+
+type ApproveSuggestedPostRequest struct {
+	ChatID    int64 `json:"chat_id"`
+	MessageID int64 `json:"message_id"`
+	// Unix time when the post is expected to be published, at most 30 days in the future.
+	// Must be zero if the post already has a send date.
+	SendDate int64 `json:"send_date,omitempty"`
+}
+
+// ApproveSuggestedPost approves a suggested post in a direct messages chat.
+// The bot must have the CanPostMessages administrator right in the corresponding channel.
+// https://core.telegram.org/bots/api#approvesuggestedpost
+func (bot *TelegramBot) ApproveSuggestedPost(chatID, messageID, sendDate int64) error {
+	return bot.CallMethod("approveSuggestedPost", &ApproveSuggestedPostRequest{
+		ChatID:    chatID,
+		MessageID: messageID,
+		SendDate:  sendDate,
+	}, nil)
+}
+
+type DeclineSuggestedPostRequest struct {
+	ChatID    int64  `json:"chat_id"`
+	MessageID int64  `json:"message_id"`
+	Comment   string `json:"comment,omitempty"` // 0-128 characters
+}
+
+// DeclineSuggestedPost declines a suggested post in a direct messages chat.
+// The bot must have the CanManageDirectMessages administrator right in the corresponding channel.
+// https://core.telegram.org/bots/api#declinesuggestedpost
+func (bot *TelegramBot) DeclineSuggestedPost(chatID, messageID int64, comment string) error {
+	return bot.CallMethod("declineSuggestedPost", &DeclineSuggestedPostRequest{
+		ChatID:    chatID,
+		MessageID: messageID,
+		Comment:   comment,
+	}, nil)
+}
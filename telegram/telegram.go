@@ -9,27 +9,39 @@ import (
 	"log"
 	"mime/multipart"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 )
 
 type Config struct {
 	API   string `json:"api"`
 	Token string `json:"token"`
+	// LinkPreviewOptions is applied to outgoing text messages that don't set their own.
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// UploadCache, if set, records the file_id of uploaded files by content hash,
+	// so sending the same local file again reuses it instead of uploading.
+	// A file_id rejected by the API is forgotten and the file uploaded again.
+	UploadCache Store `json:"-"`
+	// Templates are the message templates sent with SendTemplate.
+	Templates *Templates `json:"-"`
 }
 
 type TelegramBot struct {
 	config          *Config
 	client          *http.Client
 	IncomingMessage chan *Update
+
+	mu    sync.Mutex
+	chats *ChatTracker
 }
 
 type TelegramBotResponse struct {
-	Ok          bool            `json:"ok"`
-	Code        int             `json:"error_code,omitempty"`
-	Description string          `json:"description,omitempty"`
-	Result      json.RawMessage `json:"result"`
+	Ok          bool                `json:"ok"`
+	Code        int                 `json:"error_code,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+	Result      json.RawMessage     `json:"result"`
 }
 
 // https://core.telegram.org/bots/api#user
@@ -47,34 +59,45 @@ type User struct {
 	SupportsInlineQueries   bool   `json:"supports_inline_queries"`
 }
 
+// FullName returns the first and last name of the user.
+func (u *User) FullName() string {
+	if u.LastName == "" {
+		return u.FirstName
+	}
+	return u.FirstName + " " + u.LastName
+}
+
+// LinkPreviewOptions describes the options used for link preview generation.
+// @docs https://core.telegram.org/bots/api#linkpreviewoptions
 type LinkPreviewOptions struct {
-	IsDisable        bool   `json:"is_disable,omitempty"`
+	IsDisabled       bool   `json:"is_disabled,omitempty"`
 	URL              string `json:"url,omitempty"`
 	PreferSmallMedia bool   `json:"prefer_small_media,omitempty"`
 	PreferLargeMedia bool   `json:"prefer_large_media,omitempty"`
 	ShowAboveText    bool   `json:"show_above_text,omitempty"`
 }
 
-type MessageOrigin struct{}
-type ExternalReplyInfo struct{}
-type TextQuote struct{}
-type Animation struct{}
-type PhotoSize struct{}
-type Audio struct{}
-type Document struct{}
-type Sticker struct{}
-type Story struct{}
-type Video struct{}
-type VideoNote struct{}
-type Voice struct{}
-type Contact struct{}
-type Dice struct{}
-type Game struct{}
-type Poll struct{}
-type Venue struct{}
-type Location struct{}
-type ChatPhoto struct{}
-type ReactionType struct{}
+// LinkPreviewDisabled disables the link preview.
+func LinkPreviewDisabled() *LinkPreviewOptions {
+	return &LinkPreviewOptions{IsDisabled: true}
+}
+
+// LinkPreviewLarge shows an enlarged preview for url.
+// If url is empty, the first URL found in the message text is used.
+func LinkPreviewLarge(url string) *LinkPreviewOptions {
+	return &LinkPreviewOptions{URL: url, PreferLargeMedia: true}
+}
+
+// LinkPreviewSmall shows a shrunk preview for url.
+// If url is empty, the first URL found in the message text is used.
+func LinkPreviewSmall(url string) *LinkPreviewOptions {
+	return &LinkPreviewOptions{URL: url, PreferSmallMedia: true}
+}
+
+// LinkPreviewAbove shows the preview for url above the message text.
+func LinkPreviewAbove(url string) *LinkPreviewOptions {
+	return &LinkPreviewOptions{URL: url, ShowAboveText: true}
+}
 
 // https://core.telegram.org/bots/api#chat
 type Chat struct {
@@ -82,7 +105,7 @@ type Chat struct {
 	// This number may have more than 32 significant bits and some programming languages may have difficulty/silent defects in interpreting it.
 	// But it has at most 52 significant bits, so a signed 64-bit integer or double-precision float type are safe for storing this identifier.
 	ID                     int64           `json:"id"`
-	Type                   string          `json:"type"`
+	Type                   ChatType        `json:"type"`
 	Title                  string          `json:"title"`
 	UserName               string          `json:"username"`
 	FirstName              string          `json:"first_name"`
@@ -100,58 +123,188 @@ type Chat struct {
 
 // https://core.telegram.org/bots/api#message
 type Message struct {
-	MessageID           int64               `json:"message_id"`
-	MessageThreadID     int64               `json:"message_thread_id"`
-	From                *User               `json:"from"`
-	SenderChat          *Chat               `json:"sender_chat"`
-	Date                int                 `json:"date"`
-	Chat                *Chat               `json:"chat"`
-	ForwardOrigin       *MessageOrigin      `json:"forward_origin,omitempty"`
-	IsTopicMessage      bool                `json:"is_topic_message"`
-	IsAutomaticForward  bool                `json:"is_automatic_forward"`
-	ReplyToMessage      *Message            `json:"reply_to_message,omitempty"`
-	ExternalReply       *ExternalReplyInfo  `json:"external_reply"`
-	Quote               *TextQuote          `json:"quote,omitempty"`
-	ViaBot              *User               `json:"via_bot"`
-	EditDate            int                 `json:"edit_date,omitempty"`
-	HasProtectedContent bool                `json:"has_protected_content,omitempty"`
-	MediaGroupId        string              `json:"media_group_id,omitempty"`
-	AuthorSignature     string              `json:"author_signature,omitempty"`
-	Text                string              `json:"text"`
-	Entities            []*MessageEntity    `json:"entities"`
-	LinkPreviewOptions  *LinkPreviewOptions `json:"link_preview_options"`
-	Animation           *Animation          `json:"animation,omitempty"`
-	Audio               *Audio              `json:"audio,omitempty"`
-	Document            *Document           `json:"document,omitempty"`
-	Photo               []*PhotoSize        `json:"photo,omitempty"`
-	Sticker             *Sticker            `json:"sticker,omitempty"`
-	Story               *Story              `json:"story,omitempty"`
-	Video               *Video              `json:"video,omitempty"`
-	VideoNote           *VideoNote          `json:"video_note,omitempty"`
-	Voice               *Voice              `json:"voice,omitempty"`
-	Caption             *string             `json:"caption,omitempty"`
-	CaptionEntities     []*MessageEntity    `json:"caption_entities,omitempty"`
-	HasMediaSpoiler     bool                `json:"has_media_spoiler,omitempty"`
-	Contact             *Contact            `json:"contact,omitempty"`
-	Dice                *Dice               `json:"dice,omitempty"`
-	Game                *Game               `json:"game,omitempty"`
-	Poll                *Poll               `json:"poll,omitempty"`
-	Venue               *Venue              `json:"venue,omitempty"`
-	Location            *Location           `json:"location,omitempty"`
-	NewChatMembers      []*User             `json:"new_chat_members,omitempty"`
-	LeftChatMember      *User               `json:"left_chat_member,omitempty"`
-	NewChatTitle        string              `json:"new_chat_title,omitempty"`
-	NewChatPhoto        []*PhotoSize        `json:"new_chat_photo,omitempty"`
-	DeleteChatPhoto     bool                `json:"delete_chat_photo,omitempty"`
-	GroupChatCreated    bool                `json:"group_chat_created,omitempty"`
-}
-
-type MessageEntity struct {
-	Type   string `json:"type"`
-	Offset int    `json:"offset"`
-	Length int    `json:"length"`
-	URL    string `json:"url,omitempty"`
-	User   *User  `json:"user,omitempty"`
+	MessageID       int64 `json:"message_id"`
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// The topic of a channel direct messages chat the message belongs to
+	DirectMessagesTopic *DirectMessagesTopic `json:"direct_messages_topic,omitempty"`
+	From                *User                `json:"from,omitempty"`
+	SenderChat          *Chat                `json:"sender_chat,omitempty"`
+	// If the sender of the message boosted the chat, the number of boosts added by the user
+	SenderBoostCount int `json:"sender_boost_count,omitempty"`
+	// The bot that actually sent the message on behalf of the business account
+	SenderBusinessBot *User `json:"sender_business_bot,omitempty"`
+	Date              int   `json:"date"`
+	// Unique identifier of the business connection from which the message was received.
+	// If non-empty, the message belongs to a chat of the corresponding business account.
+	BusinessConnectionID         string                         `json:"business_connection_id,omitempty"`
+	Chat                         *Chat                          `json:"chat"`
+	ForwardOrigin                *MessageOrigin                 `json:"forward_origin,omitempty"`
+	IsTopicMessage               bool                           `json:"is_topic_message,omitempty"`
+	IsAutomaticForward           bool                           `json:"is_automatic_forward,omitempty"`
+	ReplyToMessage               *Message                       `json:"reply_to_message,omitempty"`
+	ExternalReply                *ExternalReplyInfo             `json:"external_reply,omitempty"`
+	Quote                        *TextQuote                     `json:"quote,omitempty"`
+	ReplyToStory                 *Story                         `json:"reply_to_story,omitempty"`
+	ReplyToChecklistTaskID       int                            `json:"reply_to_checklist_task_id,omitempty"`
+	ViaBot                       *User                          `json:"via_bot,omitempty"`
+	EditDate                     int                            `json:"edit_date,omitempty"`
+	HasProtectedContent          bool                           `json:"has_protected_content,omitempty"`
+	IsFromOffline                bool                           `json:"is_from_offline,omitempty"`
+	IsPaidPost                   bool                           `json:"is_paid_post,omitempty"`
+	MediaGroupId                 string                         `json:"media_group_id,omitempty"`
+	AuthorSignature              string                         `json:"author_signature,omitempty"`
+	PaidStarCount                int                            `json:"paid_star_count,omitempty"`
+	Text                         string                         `json:"text,omitempty"`
+	Entities                     []*MessageEntity               `json:"entities,omitempty"`
+	LinkPreviewOptions           *LinkPreviewOptions            `json:"link_preview_options,omitempty"`
+	SuggestedPostInfo            *SuggestedPostInfo             `json:"suggested_post_info,omitempty"`
+	EffectID                     string                         `json:"effect_id,omitempty"`
+	Animation                    *Animation                     `json:"animation,omitempty"`
+	Audio                        *Audio                         `json:"audio,omitempty"`
+	Document                     *Document                      `json:"document,omitempty"`
+	PaidMedia                    *PaidMediaInfo                 `json:"paid_media,omitempty"`
+	Photo                        []*PhotoSize                   `json:"photo,omitempty"`
+	Sticker                      *Sticker                       `json:"sticker,omitempty"`
+	Story                        *Story                         `json:"story,omitempty"`
+	Video                        *Video                         `json:"video,omitempty"`
+	VideoNote                    *VideoNote                     `json:"video_note,omitempty"`
+	Voice                        *Voice                         `json:"voice,omitempty"`
+	Caption                      *string                        `json:"caption,omitempty"`
+	CaptionEntities              []*MessageEntity               `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia        bool                           `json:"show_caption_above_media,omitempty"`
+	HasMediaSpoiler              bool                           `json:"has_media_spoiler,omitempty"`
+	Checklist                    *Checklist                     `json:"checklist,omitempty"`
+	Contact                      *Contact                       `json:"contact,omitempty"`
+	Dice                         *Dice                          `json:"dice,omitempty"`
+	Game                         *Game                          `json:"game,omitempty"`
+	Poll                         *Poll                          `json:"poll,omitempty"`
+	Venue                        *Venue                         `json:"venue,omitempty"`
+	Location                     *Location                      `json:"location,omitempty"`
+	NewChatMembers               []*User                        `json:"new_chat_members,omitempty"`
+	LeftChatMember               *User                          `json:"left_chat_member,omitempty"`
+	NewChatTitle                 string                         `json:"new_chat_title,omitempty"`
+	NewChatPhoto                 []*PhotoSize                   `json:"new_chat_photo,omitempty"`
+	DeleteChatPhoto              bool                           `json:"delete_chat_photo,omitempty"`
+	GroupChatCreated             bool                           `json:"group_chat_created,omitempty"`
+	SupergroupChatCreated        bool                           `json:"supergroup_chat_created,omitempty"`
+	ChannelChatCreated           bool                           `json:"channel_chat_created,omitempty"`
+	AutoDeleteTimerChanged       *MessageAutoDeleteTimerChanged `json:"message_auto_delete_timer_changed,omitempty"`
+	MigrateToChatID              int64                          `json:"migrate_to_chat_id,omitempty"`
+	MigrateFromChatID            int64                          `json:"migrate_from_chat_id,omitempty"`
+	PinnedMessage                *MaybeInaccessibleMessage      `json:"pinned_message,omitempty"`
+	Invoice                      *Invoice                       `json:"invoice,omitempty"`
+	SuccessfulPayment            *SuccessfulPayment             `json:"successful_payment,omitempty"`
+	RefundedPayment              *RefundedPayment               `json:"refunded_payment,omitempty"`
+	UsersShared                  *UsersShared                   `json:"users_shared,omitempty"`
+	ChatShared                   *ChatShared                    `json:"chat_shared,omitempty"`
+	ConnectedWebsite             string                         `json:"connected_website,omitempty"`
+	WriteAccessAllowed           *WriteAccessAllowed            `json:"write_access_allowed,omitempty"`
+	PassportData                 *PassportData                  `json:"passport_data,omitempty"`
+	ProximityAlertTriggered      *ProximityAlertTriggered       `json:"proximity_alert_triggered,omitempty"`
+	BoostAdded                   *ChatBoostAdded                `json:"boost_added,omitempty"`
+	ChatBackgroundSet            *ChatBackground                `json:"chat_background_set,omitempty"`
+	ChecklistTasksDone           *ChecklistTasksDone            `json:"checklist_tasks_done,omitempty"`
+	ChecklistTasksAdded          *ChecklistTasksAdded           `json:"checklist_tasks_added,omitempty"`
+	ForumTopicCreated            *ForumTopicCreated             `json:"forum_topic_created,omitempty"`
+	ForumTopicEdited             *ForumTopicEdited              `json:"forum_topic_edited,omitempty"`
+	ForumTopicClosed             *ForumTopicClosed              `json:"forum_topic_closed,omitempty"`
+	ForumTopicReopened           *ForumTopicReopened            `json:"forum_topic_reopened,omitempty"`
+	GeneralForumTopicHidden      *GeneralForumTopicHidden       `json:"general_forum_topic_hidden,omitempty"`
+	GeneralForumTopicUnhidden    *GeneralForumTopicUnhidden     `json:"general_forum_topic_unhidden,omitempty"`
+	GiveawayCreated              *GiveawayCreated               `json:"giveaway_created,omitempty"`
+	Giveaway                     *Giveaway                      `json:"giveaway,omitempty"`
+	GiveawayWinners              *GiveawayWinners               `json:"giveaway_winners,omitempty"`
+	GiveawayCompleted            *GiveawayCompleted             `json:"giveaway_completed,omitempty"`
+	PaidMessagePriceChanged      *PaidMessagePriceChanged       `json:"paid_message_price_changed,omitempty"`
+	SuggestedPostApproved        *SuggestedPostApproved         `json:"suggested_post_approved,omitempty"`
+	SuggestedPostApprovalFailed  *SuggestedPostApprovalFailed   `json:"suggested_post_approval_failed,omitempty"`
+	SuggestedPostDeclined        *SuggestedPostDeclined         `json:"suggested_post_declined,omitempty"`
+	SuggestedPostPaid            *SuggestedPostPaid             `json:"suggested_post_paid,omitempty"`
+	SuggestedPostRefunded        *SuggestedPostRefunded         `json:"suggested_post_refunded,omitempty"`
+	VideoChatScheduled           *VideoChatScheduled            `json:"video_chat_scheduled,omitempty"`
+	VideoChatStarted             *VideoChatStarted              `json:"video_chat_started,omitempty"`
+	VideoChatEnded               *VideoChatEnded                `json:"video_chat_ended,omitempty"`
+	VideoChatParticipantsInvited *VideoChatParticipantsInvited  `json:"video_chat_participants_invited,omitempty"`
+	WebAppData                   *WebAppData                    `json:"web_app_data,omitempty"`
+	ReplyMarkup                  *InlineKeyboardMarkup          `json:"reply_markup,omitempty"`
+}
+
+// IsForwarded reports whether the message was forwarded from another chat or user.
+// Use ForwardOrigin instead of the removed forward_from* fields.
+func (m *Message) IsForwarded() bool {
+	return m.ForwardOrigin != nil
+}
+
+// ForwardFrom returns the original sender of a forwarded message, if it is a visible user.
+// It replaces the forward_from field removed in Bot API 7.0.
+func (m *Message) ForwardFrom() *User {
+	if m.ForwardOrigin == nil {
+		return nil
+	}
+	return m.ForwardOrigin.SenderUser
+}
+
+// ForwardFromChat returns the original chat of a message forwarded from a channel or
+// sent on behalf of a chat. It replaces the forward_from_chat field removed in Bot API 7.0.
+func (m *Message) ForwardFromChat() *Chat {
+	if m.ForwardOrigin == nil {
+		return nil
+	}
+	if m.ForwardOrigin.Chat != nil {
+		return m.ForwardOrigin.Chat
+	}
+	return m.ForwardOrigin.SenderChat
+}
+
+// IsServiceMessage reports whether the message is a service message
+// (member changes, pinned messages, topic events, payments, ...) rather than user content.
+func (m *Message) IsServiceMessage() bool {
+	return m.NewChatMembers != nil || m.LeftChatMember != nil || m.NewChatTitle != "" ||
+		m.NewChatPhoto != nil || m.DeleteChatPhoto || m.GroupChatCreated ||
+		m.SupergroupChatCreated || m.ChannelChatCreated || m.AutoDeleteTimerChanged != nil ||
+		m.MigrateToChatID != 0 || m.MigrateFromChatID != 0 || m.PinnedMessage != nil ||
+		m.SuccessfulPayment != nil || m.RefundedPayment != nil || m.UsersShared != nil ||
+		m.ChatShared != nil || m.ConnectedWebsite != "" || m.WriteAccessAllowed != nil ||
+		m.ProximityAlertTriggered != nil || m.BoostAdded != nil || m.ChatBackgroundSet != nil ||
+		m.ChecklistTasksDone != nil || m.ChecklistTasksAdded != nil ||
+		m.ForumTopicCreated != nil || m.ForumTopicEdited != nil || m.ForumTopicClosed != nil ||
+		m.ForumTopicReopened != nil || m.GeneralForumTopicHidden != nil || m.GeneralForumTopicUnhidden != nil ||
+		m.GiveawayCreated != nil || m.GiveawayCompleted != nil || m.PaidMessagePriceChanged != nil ||
+		m.SuggestedPostApproved != nil || m.SuggestedPostApprovalFailed != nil || m.SuggestedPostDeclined != nil ||
+		m.SuggestedPostPaid != nil || m.SuggestedPostRefunded != nil ||
+		m.VideoChatScheduled != nil || m.VideoChatStarted != nil || m.VideoChatEnded != nil ||
+		m.VideoChatParticipantsInvited != nil || m.WebAppData != nil
+}
+
+// InaccessibleMessage describes a message that was deleted or is otherwise inaccessible to the bot.
+// @docs https://core.telegram.org/bots/api#inaccessiblemessage
+type InaccessibleMessage struct {
+	Chat      *Chat `json:"chat"`
+	MessageID int64 `json:"message_id"`
+	Date      int   `json:"date"` // Always 0
+}
+
+// MaybeInaccessibleMessage describes a message that can be inaccessible to the bot.
+// Both variants share the same JSON shape, so it is decoded as a Message;
+// use IsAccessible before relying on anything besides Chat and MessageID.
+// @docs https://core.telegram.org/bots/api#maybeinaccessiblemessage
+type MaybeInaccessibleMessage = Message
+
+// IsAccessible reports whether the message is a regular message
+// rather than an InaccessibleMessage, which always has a zero Date.
+func (m *Message) IsAccessible() bool {
+	return m != nil && m.Date != 0
+}
+
+// Inaccessible returns the message as an InaccessibleMessage if it is one.
+func (m *Message) Inaccessible() (msg *InaccessibleMessage, ok bool) {
+	if m == nil || m.IsAccessible() {
+		return nil, false
+	}
+	return &InaccessibleMessage{
+		Chat:      m.Chat,
+		MessageID: m.MessageID,
+	}, true
 }
 
 func NewBot(config *Config) (bot *TelegramBot) {
@@ -165,54 +318,60 @@ func NewBot(config *Config) (bot *TelegramBot) {
 	return
 }
 
-func (bot *TelegramBot) requestJson(path string, params any) (result json.RawMessage, err error) {
+func (bot *TelegramBot) requestJson(ctx context.Context, path string, params any) (result json.RawMessage, err error) {
 	body := &bytes.Buffer{}
 	err = json.NewEncoder(body).Encode(params)
 	if err != nil {
 		return
 	}
-	return bot.request(path, body, map[string]string{
+	return bot.request(ctx, path, body, map[string]string{
 		"Content-Type": "application/json",
 	})
 }
 
-func (bot *TelegramBot) requestForm(path string, form map[string]any) (result json.RawMessage, err error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	for fieldName, value := range form {
-		f, ok := value.(*os.File)
-		if ok {
-			part, err := writer.CreateFormFile(fieldName, filepath.Base(f.Name()))
-			if err != nil {
-				return nil, err
-			}
-			_, err = io.Copy(part, f)
-			if err != nil {
-				return nil, err
-			}
-			err = f.Close()
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			err = writer.WriteField(fieldName, fmt.Sprintf("%v", value))
-			if err != nil {
-				return nil, err
-			}
+// requestForm streams form as multipart/form-data, see writeMultipart.
+func (bot *TelegramBot) requestForm(ctx context.Context, path string, form map[string]any) (result json.RawMessage, err error) {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		err := writeMultipart(ctx, writer, form)
+		if err == nil {
+			err = writer.Close()
 		}
-	}
-	if err = writer.Close(); err != nil {
-		return nil, err
-	}
-	return bot.request(path, body, map[string]string{
+		pw.CloseWithError(err)
+	}()
+	result, err = bot.request(ctx, path, pr, map[string]string{
 		"Content-Type": writer.FormDataContentType(),
 	})
+	// Unblock the writer if the request failed before the body was consumed
+	pr.CloseWithError(io.ErrClosedPipe)
+	return
+}
+
+// requestUpload sends params as multipart/form-data along with the files to upload.
+func (bot *TelegramBot) requestUpload(ctx context.Context, path string, params any, files []*InputFile) (result json.RawMessage, err error) {
+	form := make(map[string]any)
+	for k, v := range ToFormValues(params) {
+		form[k] = v
+	}
+	for _, f := range files {
+		form[f.attach] = f
+	}
+	return bot.requestForm(ctx, path, form)
+}
+
+// apiURL returns the Bot API server, which may be a local server set in Config.API.
+func (bot *TelegramBot) apiURL() string {
+	if bot.config.API != "" {
+		return strings.TrimSuffix(bot.config.API, "/")
+	}
+	return "https://api.telegram.org"
 }
 
 // @docs https://core.telegram.org/bots/api#making-requests
-func (bot *TelegramBot) request(path string, body io.Reader, headers map[string]string) (result json.RawMessage, err error) {
-	url := "https://api.telegram.org/bot" + bot.config.Token + path
-	req, err := http.NewRequest(http.MethodPost, url, body)
+func (bot *TelegramBot) request(ctx context.Context, path string, body io.Reader, headers map[string]string) (result json.RawMessage, err error) {
+	url := bot.apiURL() + "/bot" + bot.config.Token + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
 	if err != nil {
 		return
 	}
@@ -223,6 +382,7 @@ func (bot *TelegramBot) request(path string, body io.Reader, headers map[string]
 	if err != nil {
 		return
 	}
+	defer res.Body.Close()
 	var out TelegramBotResponse
 	err = json.NewDecoder(res.Body).Decode(&out)
 	if err != nil {
@@ -230,25 +390,65 @@ func (bot *TelegramBot) request(path string, body io.Reader, headers map[string]
 	}
 	result = out.Result
 	if !out.Ok {
-		err = fmt.Errorf("error: %d %s", out.Code, out.Description)
+		err = &Error{Code: out.Code, Description: out.Description, Parameters: out.Parameters}
 		return
 	}
 	return
 }
 
+// requestParams sends params as JSON, or as multipart/form-data with files.
+func (bot *TelegramBot) requestParams(ctx context.Context, path string, params any, files []*InputFile) (json.RawMessage, error) {
+	if len(files) == 0 {
+		return bot.requestJson(ctx, path, params)
+	}
+	return bot.requestUpload(ctx, path, params, files)
+}
+
 // CallMethod is a generic method to call any Telegram Bot API method.
 // - method: the API method name (e.g., "getMe", "sendMessage")
 // - params: request parameters (struct or map[string]any)
 // - out: pointer to result struct to unmarshal the response
+// Structs containing an InputFile upload are streamed as multipart/form-data.
 // Returns error if the API call fails or returns a non-success response.
 func (bot *TelegramBot) CallMethod(method string, params any, out any) (err error) {
+	return bot.CallMethodContext(context.Background(), method, params, out)
+}
+
+// CallMethodContext is like CallMethod, aborting the request (and any upload in progress) when ctx is done.
+func (bot *TelegramBot) CallMethodContext(ctx context.Context, method string, params any, out any) (err error) {
 	path := fmt.Sprintf("/%s", method)
 	var result json.RawMessage
 	form, ok := params.(map[string]any)
 	if ok {
-		result, err = bot.requestForm(path, form)
+		result, err = bot.requestForm(ctx, path, form)
 	} else {
-		result, err = bot.requestJson(path, params)
+		var files []*InputFile
+		if params, files, err = collectUploads(params); err != nil {
+			return
+		}
+		var key string
+		uploads := files
+		if len(files) > 0 && bot.config.UploadCache != nil {
+			if uploads, key, err = bot.useUploadCache(method, files); err != nil {
+				return
+			}
+		}
+		var offsets []int64
+		resendable := false
+		if len(uploads) < len(files) {
+			offsets, resendable = uploadOffsets(uploads)
+		}
+		result, err = bot.requestParams(ctx, path, params, uploads)
+		if resendable && isBadRequest(err) {
+			// A cached file_id may be rejected, e.g. after a change of bot token
+			if key, err = bot.forgetUploads(files, uploads, offsets); err != nil {
+				return
+			}
+			result, err = bot.requestParams(ctx, path, params, files)
+		}
+		if err == nil && key != "" {
+			bot.rememberUpload(key, result)
+		}
 	}
 	if err != nil {
 		return
@@ -280,51 +480,60 @@ type UpdateRequest struct {
 }
 
 type Update struct {
-	UpdateId          int      `json:"update_id"`
-	Message           *Message `json:"message,omitempty"`
-	EditedMessage     *Message `json:"edited_message,omitempty"`
-	ChannelPost       *Message `json:"channel_post,omitempty"`
-	EditedChannelPost *Message `json:"edited_channel_post,omitempty"`
-	// business_connection
-	// business_message
-	// edited_business_message
-	// deleted_business_messages
-	MessageReaction      *MessageReactionUpdated      `json:"message_reaction,omitempty"`
-	MessageReactionCount *MessageReactionCountUpdated `json:"message_reaction_count,omitempty"`
-	// inline_query
-	// chosen_inline_result
-	// callback_query
-	// shipping_query
-	// pre_checkout_query
-	// purchased_paid_media
-	// poll
-	// poll_answer
-	// my_chat_member
-	// chat_member
-	// chat_join_request
-	// chat_boost
-	// removed_chat_boost
-}
-
+	UpdateId                int                          `json:"update_id"`
+	Message                 *Message                     `json:"message,omitempty"`
+	EditedMessage           *Message                     `json:"edited_message,omitempty"`
+	ChannelPost             *Message                     `json:"channel_post,omitempty"`
+	EditedChannelPost       *Message                     `json:"edited_channel_post,omitempty"`
+	BusinessConnection      *BusinessConnection          `json:"business_connection,omitempty"`
+	BusinessMessage         *Message                     `json:"business_message,omitempty"`
+	EditedBusinessMessage   *Message                     `json:"edited_business_message,omitempty"`
+	DeletedBusinessMessages *BusinessMessagesDeleted     `json:"deleted_business_messages,omitempty"`
+	MessageReaction         *MessageReactionUpdated      `json:"message_reaction,omitempty"`
+	MessageReactionCount    *MessageReactionCountUpdated `json:"message_reaction_count,omitempty"`
+	InlineQuery             *InlineQuery                 `json:"inline_query,omitempty"`
+	ChosenInlineResult      *ChosenInlineResult          `json:"chosen_inline_result,omitempty"`
+	CallbackQuery           *CallbackQuery               `json:"callback_query,omitempty"`
+	ShippingQuery           *ShippingQuery               `json:"shipping_query,omitempty"`
+	PreCheckoutQuery        *PreCheckoutQuery            `json:"pre_checkout_query,omitempty"`
+	PurchasedPaidMedia      *PaidMediaPurchased          `json:"purchased_paid_media,omitempty"`
+	Poll                    *Poll                        `json:"poll,omitempty"`
+	PollAnswer              *PollAnswer                  `json:"poll_answer,omitempty"`
+	MyChatMember            *ChatMemberUpdated           `json:"my_chat_member,omitempty"`
+	ChatMember              *ChatMemberUpdated           `json:"chat_member,omitempty"`
+	ChatJoinRequest         *ChatJoinRequest             `json:"chat_join_request,omitempty"`
+	ChatBoost               *ChatBoostUpdated            `json:"chat_boost,omitempty"`
+	RemovedChatBoost        *ChatBoostRemoved            `json:"removed_chat_boost,omitempty"`
+}
+
+// MessageReactionUpdated represents a change of a reaction on a message performed by a user.
+// The bot must be an administrator in the chat and must explicitly specify "message_reaction"
+// in the list of allowed_updates to receive these updates.
 // https://core.telegram.org/bots/api#messagereactionupdated
 type MessageReactionUpdated struct {
 	MessageID   int64      `json:"message_id"`
 	Chat        Chat       `json:"chat"`
-	User        User       `json:"user,omitempty"`
-	ActorChat   Chat       `json:"actor_chat,omitempty"`
+	User        *User      `json:"user,omitempty"`
+	ActorChat   *Chat      `json:"actor_chat,omitempty"`
 	Date        int64      `json:"date"`
 	OldReaction []Reaction `json:"old_reaction"`
 	NewReaction []Reaction `json:"new_reaction"`
 }
 
-// https://core.telegram.org/bots/api#messagereactionupdated
+// https://core.telegram.org/bots/api#reactiontype
 type Reaction struct {
 	Type          string `json:"type"` // "emoji" | "custom_emoji" | "paid"
-	Emoji         string `json:"emoji"`
-	CustomEmojiID string `json:"custom_emoji_id"`
+	Emoji         string `json:"emoji,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
 }
 
-// https://core.telegram.org/bots/api#messagereactionupdated
+// ReactionType is the name used by the Bot API documentation for Reaction.
+type ReactionType = Reaction
+
+// MessageReactionCountUpdated represents reaction changes on a message with anonymous reactions.
+// The bot must be an administrator in the chat and must explicitly specify "message_reaction_count"
+// in the list of allowed_updates to receive these updates.
+// https://core.telegram.org/bots/api#messagereactioncountupdated
 type MessageReactionCountUpdated struct {
 	MessageID int64           `json:"message_id"`
 	Chat      Chat            `json:"chat"`
@@ -332,9 +541,40 @@ type MessageReactionCountUpdated struct {
 	Reactions []ReactionCount `json:"reactions"`
 }
 
+// ReactionCount represents a reaction added to a message along with the number of times it was added.
+// https://core.telegram.org/bots/api#reactioncount
 type ReactionCount struct {
-	Type       string `json:"type"`
-	TotalCount int    `json:"total_count"`
+	Type       Reaction `json:"type"`
+	TotalCount int      `json:"total_count"`
+}
+
+// Added returns the reactions present in NewReaction but not in OldReaction.
+func (u *MessageReactionUpdated) Added() (reactions []Reaction) {
+	for _, r := range u.NewReaction {
+		if !containsReaction(u.OldReaction, r) {
+			reactions = append(reactions, r)
+		}
+	}
+	return
+}
+
+// Removed returns the reactions present in OldReaction but not in NewReaction.
+func (u *MessageReactionUpdated) Removed() (reactions []Reaction) {
+	for _, r := range u.OldReaction {
+		if !containsReaction(u.NewReaction, r) {
+			reactions = append(reactions, r)
+		}
+	}
+	return
+}
+
+func containsReaction(reactions []Reaction, r Reaction) bool {
+	for _, x := range reactions {
+		if x == r {
+			return true
+		}
+	}
+	return false
 }
 
 // GetUpdates
@@ -381,21 +621,46 @@ func (bot *TelegramBot) Start(ctx context.Context) {
 }
 
 type MessageRequest struct {
-	// business_connection_id
-	ChatID          any   `json:"chat_id"`
-	MessageThreadID int64 `json:"message_thread_id,omitempty"`
-	// direct_messages_topic_id
-	Text                string              `json:"text"`
-	ParseMode           string              `json:"parse_mode,omitempty"`
-	Entities            []*MessageEntity    `json:"entities,omitempty"`
-	LinkPreviewOptions  *LinkPreviewOptions `json:"link_preview_options,omitempty"`
-	DisableNotification bool                `json:"disable_notification,omitempty"`
-	ProtectContent      bool                `json:"protect_content,omitempty"`
+	BusinessConnectionID  string              `json:"business_connection_id,omitempty"`
+	ChatID                any                 `json:"chat_id"`
+	MessageThreadID       int64               `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64               `json:"direct_messages_topic_id,omitempty"`
+	Text                  string              `json:"text"`
+	ParseMode             ParseMode           `json:"parse_mode,omitempty"`
+	Entities              []*MessageEntity    `json:"entities,omitempty"`
+	LinkPreviewOptions    *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	DisableNotification   bool                `json:"disable_notification,omitempty"`
+	ProtectContent        bool                `json:"protect_content,omitempty"`
 	// allow_paid_broadcast bool
 	// message_effect_id string
-	// suggested_post_parameters
-	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup     any              `json:"reply_markup,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+	// StyledText, if set, replaces Text and Entities (see Text)
+	StyledText *StyledText `json:"-"`
+	// Split sends a text longer than MaxMessageLength as several messages, see SendLongMessage
+	Split bool `json:"-"`
+}
+
+// applyStyledText sets the text and entities of the message from StyledText.
+func (message *MessageRequest) applyStyledText() {
+	if message.StyledText != nil {
+		message.Text = message.StyledText.Text
+		message.Entities = message.StyledText.Entities
+		message.ParseMode = ""
+		message.StyledText = nil
+	}
+}
+
+// prepareMessage returns a copy of message as sent, with StyledText applied
+// and the default LinkPreviewOptions of the bot, leaving message untouched.
+func (bot *TelegramBot) prepareMessage(message *MessageRequest) *MessageRequest {
+	req := *message
+	req.applyStyledText()
+	if req.LinkPreviewOptions == nil {
+		req.LinkPreviewOptions = bot.config.LinkPreviewOptions
+	}
+	return &req
 }
 
 // @docs https://core.telegram.org/bots/api#replyparameters
@@ -404,31 +669,40 @@ type ReplyParameters struct {
 	ChatID                   any              `json:"chat_id,omitempty"`
 	AllowSendingWithoutReply bool             `json:"allow_sending_without_reply,omitempty"`
 	Quote                    string           `json:"quote,omitempty"`
-	QuoteParseMode           string           `json:"quote_parse_mode,omitempty"`
+	QuoteParseMode           ParseMode        `json:"quote_parse_mode,omitempty"`
 	QuoteEntities            []*MessageEntity `json:"quote_entities,omitempty"`
 	QuotePosition            int              `json:"quote_position,omitempty"`
 	ChecklistTaskID          int              `json:"checklist_task_id,omitempty"`
 }
 
-type InlineKeyboardMarkup struct{}
-type ReplyKeyboardMarkup struct{}
-type ReplyKeyboardRemove struct{}
-type ForceReply struct{}
-
 // SendMessage sends a text message to the specified chat.
+// With Split, a long text is sent as several messages and the last one is returned.
 // https://core.telegram.org/bots/api#sendmessage
 func (bot *TelegramBot) SendMessage(message *MessageRequest) (result *Message, err error) {
-	err = bot.CallMethod("sendMessage", message, &result)
+	req := bot.prepareMessage(message)
+	if req.Split && UTF16Len(req.Text) > MaxMessageLength {
+		results, err := bot.SendLongMessage(req)
+		if len(results) > 0 {
+			result = results[len(results)-1]
+		}
+		return result, err
+	}
+	err = bot.CallMethod("sendMessage", req, &result)
 	return
 }
 
 type ForwardMessageRequest struct {
-	ChatID              int  `json:"chat_id"`
-	MessageThreadID     int  `json:"message_thread_id"`
-	FromChatId          int  `json:"from_chat_id"`
-	DisableNotification bool `json:"disable_notification"`
-	ProtectContent      bool `json:"protect_content"`
-	MessageID           int  `json:"message_id"`
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatID                any   `json:"chat_id"`
+	MessageThreadID       int64 `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64 `json:"direct_messages_topic_id,omitempty"`
+	// Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
+	FromChatId              any                      `json:"from_chat_id"`
+	VideoStartTimestamp     int                      `json:"video_start_timestamp,omitempty"` // New start timestamp for the forwarded video in the message
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	MessageID               int64                    `json:"message_id"`
 }
 
 // https://core.telegram.org/bots/api#forwardmessage
@@ -437,24 +711,98 @@ func (bot *TelegramBot) ForwardMessage(req *ForwardMessageRequest) (result *Mess
 	return
 }
 
+type ForwardMessagesRequest struct {
+	ChatID                any     `json:"chat_id"`
+	MessageThreadID       int64   `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64   `json:"direct_messages_topic_id,omitempty"`
+	FromChatID            any     `json:"from_chat_id"`
+	MessageIDs            []int64 `json:"message_ids"` // 1-100 identifiers, in strictly increasing order
+	DisableNotification   bool    `json:"disable_notification,omitempty"`
+	ProtectContent        bool    `json:"protect_content,omitempty"`
+}
+
+// ForwardMessages forwards multiple messages of any kind.
+// Messages that can't be found or forwarded are skipped. Album grouping is kept for forwarded messages.
+// Returns the identifiers of the sent messages on success.
+// https://core.telegram.org/bots/api#forwardmessages
+func (bot *TelegramBot) ForwardMessages(req *ForwardMessagesRequest) (result []*MessageID, err error) {
+	err = bot.CallMethod("forwardMessages", req, &result)
+	return
+}
+
+// MessageID represents a unique message identifier.
+// @docs https://core.telegram.org/bots/api#messageid
+type MessageID struct {
+	MessageID int64 `json:"message_id"`
+}
+
+type CopyMessageRequest struct {
+	ChatID                any   `json:"chat_id"`
+	MessageThreadID       int64 `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64 `json:"direct_messages_topic_id,omitempty"`
+	FromChatID            any   `json:"from_chat_id"`
+	MessageID             int64 `json:"message_id"`
+	VideoStartTimestamp   int   `json:"video_start_timestamp,omitempty"`
+	// New caption for media. If not specified, the original caption is kept; pass a pointer to "" to remove it.
+	Caption                 *string                  `json:"caption,omitempty"`
+	ParseMode               ParseMode                `json:"parse_mode,omitempty"`
+	CaptionEntities         []*MessageEntity         `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia   bool                     `json:"show_caption_above_media,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+}
+
+// CopyMessage copies a message of any kind, without a link to the original message.
+// Service messages, paid media messages, giveaway messages, giveaway winners messages, and invoice messages can't be copied.
+// Returns the MessageID of the sent message on success.
+// https://core.telegram.org/bots/api#copymessage
+func (bot *TelegramBot) CopyMessage(req *CopyMessageRequest) (result *MessageID, err error) {
+	err = bot.CallMethod("copyMessage", req, &result)
+	return
+}
+
+type CopyMessagesRequest struct {
+	ChatID                any     `json:"chat_id"`
+	MessageThreadID       int64   `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64   `json:"direct_messages_topic_id,omitempty"`
+	FromChatID            any     `json:"from_chat_id"`
+	MessageIDs            []int64 `json:"message_ids"` // 1-100 identifiers, in strictly increasing order
+	DisableNotification   bool    `json:"disable_notification,omitempty"`
+	ProtectContent        bool    `json:"protect_content,omitempty"`
+	RemoveCaption         bool    `json:"remove_caption,omitempty"`
+}
+
+// CopyMessages copies messages of any kind. Messages that can't be copied are skipped.
+// Album grouping is kept for copied messages.
+// Returns the identifiers of the sent messages on success.
+// https://core.telegram.org/bots/api#copymessages
+func (bot *TelegramBot) CopyMessages(req *CopyMessagesRequest) (result []*MessageID, err error) {
+	err = bot.CallMethod("copyMessages", req, &result)
+	return
+}
+
 type SendLocationRequest struct {
-	// business_connection_id
-	ChatID          any   `json:"chat_id"`
-	MessageThreadID int64 `json:"message_thread_id,omitempty"`
-	// direct_messages_topic_id
-	Latitute             float32 `json:"latitude"`
-	Longitude            float32 `json:"longitude"`
-	HorizontalAccuracy   int     `json:"horizontal_accuracy,omitempty"`
-	LivePeriod           int     `json:"live_period,omitempty"`
-	Heading              int     `json:"heading,omitempty"`
-	ProximityAlertRadius int     `json:"proximity_alert_radius,omitempty"`
-	DisableNotification  bool    `json:"disable_notification,omitempty"`
-	ProtectContent       bool    `json:"protect_content,omitempty"`
+	BusinessConnectionID  string  `json:"business_connection_id,omitempty"`
+	ChatID                any     `json:"chat_id"`
+	MessageThreadID       int64   `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64   `json:"direct_messages_topic_id,omitempty"`
+	Latitude              float64 `json:"latitude"`
+	Longitude             float64 `json:"longitude"`
+	HorizontalAccuracy    float64 `json:"horizontal_accuracy,omitempty"` // 0-1500 meters
+	LivePeriod            int     `json:"live_period,omitempty"`
+	Heading               int     `json:"heading,omitempty"`
+	ProximityAlertRadius  int     `json:"proximity_alert_radius,omitempty"`
+	DisableNotification   bool    `json:"disable_notification,omitempty"`
+	ProtectContent        bool    `json:"protect_content,omitempty"`
 	// allow_paid_broadcast
 	// message_effect_id
-	// suggested_post_parameters
-	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup     any              `json:"reply_markup,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // https://core.telegram.org/bots/api#sendlocation
@@ -463,23 +811,117 @@ func (bot *TelegramBot) SendLocation(req *SendLocationRequest) (result *Message,
 	return
 }
 
+type EditMessageLiveLocationRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               any                   `json:"chat_id,omitempty"`
+	MessageID            int64                 `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	Latitude             float64               `json:"latitude"`
+	Longitude            float64               `json:"longitude"`
+	LivePeriod           int                   `json:"live_period,omitempty"` // New period in seconds, or 0x7FFFFFFF to edit indefinitely
+	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty"`
+	Heading              int                   `json:"heading,omitempty"`
+	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageLiveLocation edits live location messages.
+// A location can be edited until its LivePeriod expires or editing is explicitly disabled by a call to StopMessageLiveLocation.
+// For inline messages the returned message is nil.
+// https://core.telegram.org/bots/api#editmessagelivelocation
+func (bot *TelegramBot) EditMessageLiveLocation(req *EditMessageLiveLocationRequest) (message *Message, err error) {
+	return bot.callEdit("editMessageLiveLocation", req)
+}
+
+type StopMessageLiveLocationRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               any                   `json:"chat_id,omitempty"`
+	MessageID            int64                 `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopMessageLiveLocation stops updating a live location message before LivePeriod expires.
+// For inline messages the returned message is nil.
+// https://core.telegram.org/bots/api#stopmessagelivelocation
+func (bot *TelegramBot) StopMessageLiveLocation(req *StopMessageLiveLocationRequest) (message *Message, err error) {
+	return bot.callEdit("stopMessageLiveLocation", req)
+}
+
+type SendVenueRequest struct {
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	Latitude                float64                  `json:"latitude"`
+	Longitude               float64                  `json:"longitude"`
+	Title                   string                   `json:"title"`
+	Address                 string                   `json:"address"`
+	FoursquareID            string                   `json:"foursquare_id,omitempty"`
+	FoursquareType          string                   `json:"foursquare_type,omitempty"` // For example, "arts_entertainment/default"
+	GooglePlaceID           string                   `json:"google_place_id,omitempty"`
+	GooglePlaceType         string                   `json:"google_place_type,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+}
+
+// https://core.telegram.org/bots/api#sendvenue
+func (bot *TelegramBot) SendVenue(req *SendVenueRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendVenue", req, &result)
+	return
+}
+
+type SendContactRequest struct {
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	PhoneNumber             string                   `json:"phone_number"`
+	FirstName               string                   `json:"first_name"`
+	LastName                string                   `json:"last_name,omitempty"`
+	VCard                   string                   `json:"vcard,omitempty"` // Additional data about the contact in the form of a vCard, 0-2048 bytes
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+}
+
+// https://core.telegram.org/bots/api#sendcontact
+func (bot *TelegramBot) SendContact(req *SendContactRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendContact", req, &result)
+	return
+}
+
+// InputPollOption contains information about one answer option in a poll to be sent.
+// @docs https://core.telegram.org/bots/api#inputpolloption
 type InputPollOption struct {
+	Text          string           `json:"text"` // 1-100 characters
+	TextParseMode ParseMode        `json:"text_parse_mode,omitempty"`
+	TextEntities  []*MessageEntity `json:"text_entities,omitempty"`
 }
 
 type SendPollRequest struct {
-	// business_connection_id
+	BusinessConnectionID  string            `json:"business_connection_id,omitempty"`
 	ChatID                any               `json:"chat_id"`
 	MessageThreadID       int               `json:"message_thread_id,omitempty"`
 	Question              string            `json:"question"`
-	QuestionParseMode     string            `json:"question_parse_mode,omitempty"`
+	QuestionParseMode     ParseMode         `json:"question_parse_mode,omitempty"`
 	QuestionEntities      []*MessageEntity  `json:"question_entities,omitempty"`
 	Options               []InputPollOption `json:"options"`
-	IsAnonymous           bool              `json:"is_anonymous,omitempty"`
-	Type                  string            `json:"type,omitempty"`
+	IsAnonymous           *bool             `json:"is_anonymous,omitempty"` // Defaults to true
+	Type                  PollType          `json:"type,omitempty"`
 	AllowsMultipleAnswers bool              `json:"allows_multiple_answers,omitempty"`
-	CorrectOptionID       int               `json:"correct_option_id,omitempty"`
+	CorrectOptionID       *int              `json:"correct_option_id,omitempty"` // Required for quizzes
 	Explanation           string            `json:"explanation,omitempty"`
-	ExplanationParseMode  string            `json:"explanation_parse_mode,omitempty"`
+	ExplanationParseMode  ParseMode         `json:"explanation_parse_mode,omitempty"`
 	ExplanationEntities   []*MessageEntity  `json:"explanation_entities,omitempty"`
 	OpenPeriod            int               `json:"open_period,omitempty"`
 	CloseDate             int               `json:"close_date,omitempty"`
@@ -489,7 +931,7 @@ type SendPollRequest struct {
 	// allow_paid_broadcast
 	// message_effect_id
 	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup     any              `json:"reply_markup,omitempty"`
+	ReplyMarkup     ReplyMarkup      `json:"reply_markup,omitempty"`
 }
 
 // https://core.telegram.org/bots/api#sendpoll
@@ -498,16 +940,32 @@ func (bot *TelegramBot) SendPoll(req *SendPollRequest) (result *Message, err err
 	return
 }
 
+type StopPollRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               any                   `json:"chat_id"`
+	MessageID            int64                 `json:"message_id"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopPoll stops a poll which was sent by the bot.
+// On success, the stopped Poll with the final results is returned.
+// https://core.telegram.org/bots/api#stoppoll
+func (bot *TelegramBot) StopPoll(req *StopPollRequest) (poll *Poll, err error) {
+	err = bot.CallMethod("stopPoll", req, &poll)
+	return
+}
+
 type SendDiceRequest struct {
-	// business_connection_id
-	ChatID          any   `json:"chat_id"`
-	MessageThreadID int64 `json:"message_thread_id,omitempty"`
-	// direct_messages_topic_id
-	Emoji               string           `json:"emoji,omitempty"`
-	DisableNotification bool             `json:"disable_notification"`
-	ProtectContent      bool             `json:"protect_content"`
-	ReplyParameters     *ReplyParameters `json:"reply_parameters"`
-	ReplyMarkup         any              `json:"reply_markup"`
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	Emoji                   DiceEmoji                `json:"emoji,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // https://core.telegram.org/bots/api#senddice
@@ -517,32 +975,130 @@ func (bot *TelegramBot) SendDice(req *SendDiceRequest) (result *Message, err err
 }
 
 type EditMessageTextRequest struct {
-	ChatID             any                 `json:"chat_id,omitempty"`
-	MessageID          int64               `json:"message_id,omitempty"`
-	InlineMessageID    string              `json:"inline_message_id,omitempty"`
-	Text               string              `json:"text"`
-	ParseMode          string              `json:"parse_mode,omitempty"`
-	Entities           []*MessageEntity    `json:"entities,omitempty"`
-	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
-	ReplyMarkup        any                 `json:"reply_markup,omitempty"`
-}
-
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               any                   `json:"chat_id,omitempty"`
+	MessageID            int64                 `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	Text                 string                `json:"text"`
+	ParseMode            ParseMode             `json:"parse_mode,omitempty"`
+	Entities             []*MessageEntity      `json:"entities,omitempty"`
+	LinkPreviewOptions   *LinkPreviewOptions   `json:"link_preview_options,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageText edits text and game messages.
+// Address the message either with ChatID and MessageID, or with InlineMessageID;
+// for inline messages the returned message is nil.
+// Editing a message without changes returns an error matching ErrMessageNotModified:
+//
+//	if errors.Is(err, telegram.ErrMessageNotModified) { ... }
+//
 // https://core.telegram.org/bots/api#editmessagetext
 func (bot *TelegramBot) EditMessageText(req *EditMessageTextRequest) (message *Message, err error) {
-	data, err := bot.requestJson("/editMessageText", req)
-	if err != nil {
-		return
+	if req.LinkPreviewOptions == nil {
+		edit := *req
+		edit.LinkPreviewOptions = bot.config.LinkPreviewOptions
+		req = &edit
+	}
+	return bot.callEdit("editMessageText", req)
+}
+
+// callEdit calls an edit method, which returns the edited Message,
+// or True if an inline message was edited.
+func (bot *TelegramBot) callEdit(method string, req any) (message *Message, err error) {
+	var result json.RawMessage
+	err = bot.CallMethod(method, req, &result)
+	if err != nil || string(result) == "true" {
+		return nil, err
 	}
-	err = json.Unmarshal(data, &message)
+	err = json.Unmarshal(result, &message)
 	return
 }
 
+type EditMessageCaptionRequest struct {
+	BusinessConnectionID  string                `json:"business_connection_id,omitempty"`
+	ChatID                any                   `json:"chat_id,omitempty"`
+	MessageID             int64                 `json:"message_id,omitempty"`
+	InlineMessageID       string                `json:"inline_message_id,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             ParseMode             `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity      `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool                  `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageCaption edits captions of messages.
+// For inline messages the returned message is nil.
+// https://core.telegram.org/bots/api#editmessagecaption
+func (bot *TelegramBot) EditMessageCaption(req *EditMessageCaptionRequest) (message *Message, err error) {
+	return bot.callEdit("editMessageCaption", req)
+}
+
+type EditMessageMediaRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               any                   `json:"chat_id,omitempty"`
+	MessageID            int64                 `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	Media                InputMedia            `json:"media"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageMedia edits animation, audio, document, photo, or video messages,
+// or replaces text messages with a media message.
+// If a message is part of a message album, then it can be edited only to an audio for audio albums,
+// only to a document for document albums and to a photo or a video otherwise.
+// When an inline message is edited, a new file can't be uploaded; use a previously uploaded file via its file_id or specify a URL.
+// New files are uploaded with a multipart request automatically. For inline messages the returned message is nil.
+// https://core.telegram.org/bots/api#editmessagemedia
+func (bot *TelegramBot) EditMessageMedia(req *EditMessageMediaRequest) (message *Message, err error) {
+	return bot.callEdit("editMessageMedia", req)
+}
+
+type EditMessageReplyMarkupRequest struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               any                   `json:"chat_id,omitempty"`
+	MessageID            int64                 `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Leave nil to remove the keyboard
+}
+
+// EditMessageReplyMarkup edits only the reply markup of messages.
+// For inline messages the returned message is nil.
+// https://core.telegram.org/bots/api#editmessagereplymarkup
+func (bot *TelegramBot) EditMessageReplyMarkup(req *EditMessageReplyMarkupRequest) (message *Message, err error) {
+	return bot.callEdit("editMessageReplyMarkup", req)
+}
+
+type DeleteMessageRequest struct {
+	ChatID    any   `json:"chat_id"`
+	MessageID int64 `json:"message_id"`
+}
+
+// DeleteMessage deletes a message, including service messages.
+// A message can only be deleted if it was sent less than 48 hours ago,
+// except for the bot's own outgoing messages in private chats, groups and supergroups.
+// https://core.telegram.org/bots/api#deletemessage
+func (bot *TelegramBot) DeleteMessage(chatID any, messageID int64) error {
+	return bot.CallMethod("deleteMessage", &DeleteMessageRequest{ChatID: chatID, MessageID: messageID}, nil)
+}
+
+type DeleteMessagesRequest struct {
+	ChatID     any     `json:"chat_id"`
+	MessageIDs []int64 `json:"message_ids"` // 1-100 identifiers
+}
+
+// DeleteMessages deletes multiple messages at once, skipping those which can't be deleted.
+// https://core.telegram.org/bots/api#deletemessages
+func (bot *TelegramBot) DeleteMessages(chatID any, messageIDs []int64) error {
+	return bot.CallMethod("deleteMessages", &DeleteMessagesRequest{ChatID: chatID, MessageIDs: messageIDs}, nil)
+}
+
 type MessageDraftRequest struct {
 	ChatID          int64            `json:"chat_id"`
 	MessageThreadID int64            `json:"message_thread_id,omitempty"`
 	DraftID         int64            `json:"draft_id"`
 	Text            string           `json:"text"`
-	ParseMode       string           `json:"parse_mode,omitempty"`
+	ParseMode       ParseMode        `json:"parse_mode,omitempty"`
 	Entities        []*MessageEntity `json:"entities,omitempty"`
 }
 
@@ -553,17 +1109,55 @@ func (bot *TelegramBot) SendMessageDraft(req *MessageDraftRequest) error {
 }
 
 type ChatAction struct {
-	// business_connection_id
-	ChatID          any    `json:"chat_id"`
-	MessageThreadID int64  `json:"message_thread_id,omitempty"`
-	Action          string `json:"action"`
+	BusinessConnectionID string         `json:"business_connection_id,omitempty"`
+	ChatID               any            `json:"chat_id"`
+	MessageThreadID      int64          `json:"message_thread_id,omitempty"`
+	Action               ChatActionType `json:"action"`
 }
 
 // SendChatAction sends a chat action to show status (typing, upload_photo, etc.)
 // https://core.telegram.org/bots/api#sendchataction
 func (bot *TelegramBot) SendChatAction(action *ChatAction) error {
-	_, err := bot.requestJson("/sendChatAction", action)
-	return err
+	return bot.CallMethod("sendChatAction", action, nil)
+}
+
+// chatActionInterval is how often WithChatAction repeats the action.
+// Telegram clients clear the status after 5 seconds.
+var chatActionInterval = 4 * time.Second
+
+// WithChatAction shows action in the chat while fn runs, repeating it
+// every few seconds until fn returns or ctx is cancelled.
+// Errors from sending the action are ignored; the error of fn is returned.
+func (bot *TelegramBot) WithChatAction(ctx context.Context, chatID any, action ChatActionType, fn func() error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	send := func() {
+		bot.SendChatAction(&ChatAction{ChatID: chatID, Action: action})
+	}
+	send()
+	go func() {
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				send()
+			}
+		}
+	}()
+	return fn()
+}
+
+// WithTyping shows the "typing..." status in the chat while fn runs.
+//
+//	err := bot.WithTyping(ctx, chatID, func() error {
+//		reply, err = generateReply(prompt)
+//		return err
+//	})
+func (bot *TelegramBot) WithTyping(ctx context.Context, chatID any, fn func() error) error {
+	return bot.WithChatAction(ctx, chatID, ChatActionTyping, fn)
 }
 
 type MessageReaction struct {
@@ -580,8 +1174,19 @@ type MessageReaction struct {
 // Bots can't use paid reactions. Returns True on success.
 // @docs https://core.telegram.org/bots/api#setmessagereaction
 func (bot *TelegramBot) SetMessageReaction(reaction MessageReaction) error {
-	_, err := bot.requestJson("/setMessageReaction", reaction)
-	return err
+	return bot.CallMethod("setMessageReaction", reaction, nil)
+}
+
+// React sets the bot's reaction on message to the given emojis.
+// Calling it without emojis removes the bot's reactions.
+//
+//	bot.React(update.Message, "👍")
+func (bot *TelegramBot) React(message *Message, emojis ...string) error {
+	return bot.SetMessageReaction(MessageReaction{
+		ChatID:    message.Chat.ID,
+		MessageID: message.MessageID,
+		Reaction:  NewReaction(emojis...),
+	})
 }
 
 func NewReaction(emojis ...string) (reactions []Reaction) {
@@ -595,238 +1200,252 @@ func NewReaction(emojis ...string) (reactions []Reaction) {
 	return
 }
 
-type PhotoRequest struct {
-	// business_connection_id
-	ChatID              any              `json:"chat_id"`
-	MessageThreadID     int64            `json:"message_thread_id,omitempty"`
-	Photo               string           `json:"photo"` // file_id, URL, or "attach://file_name" for file upload
-	Caption             string           `json:"caption,omitempty"`
-	ParseMode           string           `json:"parse_mode,omitempty"`
-	CaptionEntities     []*MessageEntity `json:"caption_entities,omitempty"`
-	HasSpoiler          bool             `json:"has_spoiler,omitempty"`
-	DisableNotification bool             `json:"disable_notification,omitempty"`
-	ProtectContent      bool             `json:"protect_content,omitempty"`
-	ReplyParameters     *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup         any              `json:"reply_markup,omitempty"`
-}
-
-// handleFileField handles file upload for Telegram bot API fields.
-// If the fieldValue starts with "file://", it opens the local file and adds it to the form.
-// Returns the form map, the opened file (if any), and any error.
-func prepareForm(params any, fieldName string) (map[string]any, *os.File, error) {
-	form := ToFormValues(params)
-	result := make(map[string]any)
-	for k, v := range form {
-		result[k] = v
-	}
-	fieldValue := form[fieldName]
-	if !strings.HasPrefix(fieldValue, "file://") {
-		return result, nil, nil
-	}
-	filePath := strings.TrimPrefix(fieldValue, "file://")
-	f, err := os.Open(filePath)
-	if err != nil {
-		return nil, nil, err
+// NewCustomEmojiReaction returns reactions for the given custom emoji identifiers.
+func NewCustomEmojiReaction(customEmojiIDs ...string) (reactions []Reaction) {
+	for _, id := range customEmojiIDs {
+		reaction := Reaction{
+			Type:          "custom_emoji",
+			CustomEmojiID: id,
+		}
+		reactions = append(reactions, reaction)
 	}
-	fileName := filepath.Base(f.Name())
-	result[fileName] = f
-	result[fieldName] = fmt.Sprintf("attach://%s", fileName)
-	return result, f, nil
+	return
+}
+
+// NewPaidReaction returns the paid reaction. Bots can receive it, but can't set it.
+func NewPaidReaction() Reaction {
+	return Reaction{Type: "paid"}
+}
+
+type PhotoRequest struct {
+	BusinessConnectionID  string           `json:"business_connection_id,omitempty"`
+	ChatID                any              `json:"chat_id"`
+	MessageThreadID       int64            `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64            `json:"direct_messages_topic_id,omitempty"`
+	Photo                 *InputFile       `json:"photo"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+	DisableNotification   bool             `json:"disable_notification,omitempty"`
+	ProtectContent        bool             `json:"protect_content,omitempty"`
+	// allow_paid_broadcast
+	// message_effect_id
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // SendPhoto sends a photo to the specified chat.
-// Photo can be a file_id, URL, or "attach://file_name" for file upload.
-// When using "attach://file_name", set File field to the local file path.
+// Photo can be a file_id, URL, or a new file to upload (see InputFile).
 // https://core.telegram.org/bots/api#sendphoto
 func (bot *TelegramBot) SendPhoto(req *PhotoRequest) (result *Message, err error) {
-	form, f, err := prepareForm(req, "photo")
-	if err != nil {
-		return nil, err
-	}
-	if f != nil {
-		defer f.Close()
-	}
-	err = bot.CallMethod("sendPhoto", form, &result)
+	err = bot.CallMethod("sendPhoto", req, &result)
 	return
 }
 
 type VideoRequest struct {
-	// business_connection_id
-	ChatID              any              `json:"chat_id"`
-	MessageThreadID     int64            `json:"message_thread_id,omitempty"`
-	Video               string           `json:"video"` // file_id, URL, or "attach://file_name" for file upload
-	Caption             string           `json:"caption,omitempty"`
-	ParseMode           string           `json:"parse_mode,omitempty"`
-	CaptionEntities     []*MessageEntity `json:"caption_entities,omitempty"`
-	HasSpoiler          bool             `json:"has_spoiler,omitempty"`
-	Duration            int              `json:"duration,omitempty"`
-	Width               int              `json:"width,omitempty"`
-	Height              int              `json:"height,omitempty"`
-	Thumbnail           string           `json:"thumbnail,omitempty"`
-	DisableNotification bool             `json:"disable_notification,omitempty"`
-	ProtectContent      bool             `json:"protect_content,omitempty"`
-	ReplyParameters     *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup         any              `json:"reply_markup,omitempty"`
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	Video                   *InputFile               `json:"video"`
+	Caption                 string                   `json:"caption,omitempty"`
+	ParseMode               ParseMode                `json:"parse_mode,omitempty"`
+	CaptionEntities         []*MessageEntity         `json:"caption_entities,omitempty"`
+	HasSpoiler              bool                     `json:"has_spoiler,omitempty"`
+	Duration                int                      `json:"duration,omitempty"`
+	Width                   int                      `json:"width,omitempty"`
+	Height                  int                      `json:"height,omitempty"`
+	Thumbnail               *InputFile               `json:"thumbnail,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // SendVideo sends a video to the specified chat.
-// Video can be a file_id, URL, or "attach://file_name" for file upload.
+// Video can be a file_id, URL, or a new file to upload (see InputFile).
 // https://core.telegram.org/bots/api#sendvideo
 func (bot *TelegramBot) SendVideo(req *VideoRequest) (result *Message, err error) {
-	form, f, err := prepareForm(req, "video")
-	if err != nil {
-		return nil, err
-	}
-	if f != nil {
-		defer f.Close()
-	}
-	err = bot.CallMethod("sendVideo", form, &result)
+	err = bot.CallMethod("sendVideo", req, &result)
 	return
 }
 
 type DocumentRequest struct {
-	// business_connection_id
-	ChatID                      any              `json:"chat_id"`
-	MessageThreadID             int64            `json:"message_thread_id,omitempty"`
-	Document                    string           `json:"document"` // file_id, URL, or "attach://file_name" for file upload
-	Caption                     string           `json:"caption,omitempty"`
-	ParseMode                   string           `json:"parse_mode,omitempty"`
-	CaptionEntities             []*MessageEntity `json:"caption_entities,omitempty"`
-	DisableContentTypeDetection bool             `json:"disable_content_type_detection,omitempty"`
-	Thumbnail                   string           `json:"thumbnail,omitempty"`
-	DisableNotification         bool             `json:"disable_notification,omitempty"`
-	ProtectContent              bool             `json:"protect_content,omitempty"`
-	ReplyParameters             *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup                 any              `json:"reply_markup,omitempty"`
+	BusinessConnectionID        string                   `json:"business_connection_id,omitempty"`
+	ChatID                      any                      `json:"chat_id"`
+	MessageThreadID             int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID       int64                    `json:"direct_messages_topic_id,omitempty"`
+	Document                    *InputFile               `json:"document"`
+	Caption                     string                   `json:"caption,omitempty"`
+	ParseMode                   ParseMode                `json:"parse_mode,omitempty"`
+	CaptionEntities             []*MessageEntity         `json:"caption_entities,omitempty"`
+	DisableContentTypeDetection bool                     `json:"disable_content_type_detection,omitempty"`
+	Thumbnail                   *InputFile               `json:"thumbnail,omitempty"`
+	DisableNotification         bool                     `json:"disable_notification,omitempty"`
+	ProtectContent              bool                     `json:"protect_content,omitempty"`
+	SuggestedPostParameters     *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters             *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup                 ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // SendDocument sends a document to the specified chat.
-// Document can be a file_id, URL, or "attach://file_name" for file upload.
+// Document can be a file_id, URL, or a new file to upload (see InputFile).
 // https://core.telegram.org/bots/api#senddocument
 func (bot *TelegramBot) SendDocument(req *DocumentRequest) (result *Message, err error) {
-	form, f, err := prepareForm(req, "document")
-	if err != nil {
-		return nil, err
-	}
-	if f != nil {
-		defer f.Close()
-	}
-	err = bot.CallMethod("sendDocument", form, &result)
+	err = bot.CallMethod("sendDocument", req, &result)
 	return
 }
 
 type AudioRequest struct {
-	// business_connection_id
-	ChatID          any   `json:"chat_id"`
-	MessageThreadID int64 `json:"message_thread_id,omitempty"`
-	// direct_messages_topic_id
-	Audio               string           `json:"audio"` // file_id, URL, or "attach://file_name" for file upload
-	Caption             string           `json:"caption,omitempty"`
-	ParseMode           string           `json:"parse_mode,omitempty"`
-	CaptionEntities     []*MessageEntity `json:"caption_entities,omitempty"`
-	Duration            int              `json:"duration,omitempty"`
-	Performer           string           `json:"performer,omitempty"`
-	Title               string           `json:"title,omitempty"`
-	Thumbnail           string           `json:"thumbnail,omitempty"`
-	DisableNotification bool             `json:"disable_notification,omitempty"`
-	// allow_paid_broadcast
-	// message_effect_id
-	// suggested_post_parameters
-	ProtectContent  bool             `json:"protect_content,omitempty"`
-	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup     any              `json:"reply_markup,omitempty"`
+	BusinessConnectionID  string           `json:"business_connection_id,omitempty"`
+	ChatID                any              `json:"chat_id"`
+	MessageThreadID       int64            `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64            `json:"direct_messages_topic_id,omitempty"`
+	Audio                 *InputFile       `json:"audio"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             ParseMode        `json:"parse_mode,omitempty"`
+	CaptionEntities       []*MessageEntity `json:"caption_entities,omitempty"`
+	Duration              int              `json:"duration,omitempty"`
+	Performer             string           `json:"performer,omitempty"`
+	Title                 string           `json:"title,omitempty"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size, width and height should not exceed 320.
+	// Thumbnails can't be reused and can be only uploaded as a new file.
+	Thumbnail               *InputFile               `json:"thumbnail,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // SendAudio sends an audio file to the specified chat.
-// Audio can be a file_id, URL, or "attach://file_name" for file upload.
+// Your audio must be in the .MP3 or .M4A format. Bots can currently send audio files of up to 50 MB in size.
+// For sending voice messages, use SendVoice instead.
+// Audio can be a file_id, URL, or a new file to upload (see InputFile).
 // https://core.telegram.org/bots/api#sendaudio
 func (bot *TelegramBot) SendAudio(req *AudioRequest) (result *Message, err error) {
-	form, f, err := prepareForm(req, "audio")
-	if err != nil {
-		return nil, err
-	}
-	if f != nil {
-		defer f.Close()
-	}
-	err = bot.CallMethod("sendAudio", form, &result)
+	err = bot.CallMethod("sendAudio", req, &result)
 	return
 }
 
 type VoiceRequest struct {
-	// business_connection_id
-	ChatID              any              `json:"chat_id"`
-	MessageThreadID     int64            `json:"message_thread_id,omitempty"`
-	Voice               string           `json:"voice"` // file_id, URL, or "attach://file_name" for file upload
-	Caption             string           `json:"caption,omitempty"`
-	ParseMode           string           `json:"parse_mode,omitempty"`
-	CaptionEntities     []*MessageEntity `json:"caption_entities,omitempty"`
-	Duration            int              `json:"duration,omitempty"`
-	DisableNotification bool             `json:"disable_notification,omitempty"`
-	ProtectContent      bool             `json:"protect_content,omitempty"`
-	ReplyParameters     *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup         any              `json:"reply_markup,omitempty"`
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	Voice                   *InputFile               `json:"voice"`
+	Caption                 string                   `json:"caption,omitempty"`
+	ParseMode               ParseMode                `json:"parse_mode,omitempty"`
+	CaptionEntities         []*MessageEntity         `json:"caption_entities,omitempty"`
+	Duration                int                      `json:"duration,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
 // SendVoice sends a voice message to the specified chat.
-// Voice can be a file_id, URL, or "attach://file_name" for file upload.
+// For this to work, your audio must be in an .OGG file encoded with OPUS, or in .MP3 format, or in .M4A format
+// (other formats may be sent as Audio or Document). Bots can currently send voice messages of up to 50 MB in size.
+// Voice can be a file_id, URL, or a new file to upload (see InputFile).
 // https://core.telegram.org/bots/api#sendvoice
 func (bot *TelegramBot) SendVoice(req *VoiceRequest) (result *Message, err error) {
-	form, f, err := prepareForm(req, "voice")
-	if err != nil {
-		return nil, err
-	}
-	if f != nil {
-		defer f.Close()
-	}
-	err = bot.CallMethod("sendVoice", form, &result)
+	err = bot.CallMethod("sendVoice", req, &result)
 	return
 }
 
-type AnimationRequest struct {
-	// business_connection_id
-	ChatID              any              `json:"chat_id"`
-	MessageThreadID     int64            `json:"message_thread_id,omitempty"`
-	Animation           string           `json:"animation"` // file_id, URL, or "attach://file_name" for file upload
-	Caption             string           `json:"caption,omitempty"`
-	ParseMode           string           `json:"parse_mode,omitempty"`
-	CaptionEntities     []*MessageEntity `json:"caption_entities,omitempty"`
-	HasSpoiler          bool             `json:"has_spoiler,omitempty"`
-	Duration            int              `json:"duration,omitempty"`
-	Width               int              `json:"width,omitempty"`
-	Height              int              `json:"height,omitempty"`
-	Thumbnail           string           `json:"thumbnail,omitempty"`
-	DisableNotification bool             `json:"disable_notification,omitempty"`
-	ProtectContent      bool             `json:"protect_content,omitempty"`
-	ReplyParameters     *ReplyParameters `json:"reply_parameters,omitempty"`
-	ReplyMarkup         any              `json:"reply_markup,omitempty"`
-}
-
-// SendAnimation sends an animation file (GIF or H.264 MP4) to the specified chat.
-// Animation can be a file_id, URL, or "attach://file_name" for file upload.
-// https://core.telegram.org/bots/api#sendanimation
-func (bot *TelegramBot) SendAnimation(req *AnimationRequest) (result *Message, err error) {
-	form, f, err := prepareForm(req, "animation")
-	if err != nil {
-		return nil, err
-	}
-	if f != nil {
-		defer f.Close()
-	}
-	err = bot.CallMethod("sendAnimation", form, &result)
+type VideoNoteRequest struct {
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	VideoNote               *InputFile               `json:"video_note"` // Sending video notes by a URL is currently unsupported
+	Duration                int                      `json:"duration,omitempty"`
+	Length                  int                      `json:"length,omitempty"` // Video width and height, i.e. diameter of the video message
+	Thumbnail               *InputFile               `json:"thumbnail,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+}
+
+// SendVideoNote sends a rounded square MPEG4 video of up to 1 minute long.
+// VideoNote can be a file_id or a new file to upload (see InputFile).
+// https://core.telegram.org/bots/api#sendvideonote
+func (bot *TelegramBot) SendVideoNote(req *VideoNoteRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendVideoNote", req, &result)
 	return
 }
 
-type ChatMenuButton struct {
-	ChatID     int64      `json:"chat_id,omitempty"`
-	MenuButton MenuButton `json:"menu_button,omitempty"`
+type StickerRequest struct {
+	BusinessConnectionID  string `json:"business_connection_id,omitempty"`
+	ChatID                any    `json:"chat_id"`
+	MessageThreadID       int64  `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID int64  `json:"direct_messages_topic_id,omitempty"`
+	// Video and animated stickers can't be sent via an HTTP URL.
+	Sticker                 *InputFile               `json:"sticker"`
+	Emoji                   string                   `json:"emoji,omitempty"` // Emoji associated with the sticker; only for just uploaded stickers
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
+}
+
+// SendSticker sends a static .WEBP, animated .TGS, or video .WEBM sticker.
+// Sticker can be a file_id, URL (.WEBP only), or a new file to upload (see InputFile).
+// https://core.telegram.org/bots/api#sendsticker
+func (bot *TelegramBot) SendSticker(req *StickerRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendSticker", req, &result)
+	return
 }
 
-type MenuButton struct {
-	Type string `json:"type"` // "commands" | "web_app" | "default"
+type AnimationRequest struct {
+	BusinessConnectionID    string                   `json:"business_connection_id,omitempty"`
+	ChatID                  any                      `json:"chat_id"`
+	MessageThreadID         int64                    `json:"message_thread_id,omitempty"`
+	DirectMessagesTopicID   int64                    `json:"direct_messages_topic_id,omitempty"`
+	Animation               *InputFile               `json:"animation"`
+	Duration                int                      `json:"duration,omitempty"`
+	Width                   int                      `json:"width,omitempty"`
+	Height                  int                      `json:"height,omitempty"`
+	Thumbnail               *InputFile               `json:"thumbnail,omitempty"`
+	Caption                 string                   `json:"caption,omitempty"`
+	ParseMode               ParseMode                `json:"parse_mode,omitempty"`
+	CaptionEntities         []*MessageEntity         `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia   bool                     `json:"show_caption_above_media,omitempty"`
+	HasSpoiler              bool                     `json:"has_spoiler,omitempty"`
+	DisableNotification     bool                     `json:"disable_notification,omitempty"`
+	ProtectContent          bool                     `json:"protect_content,omitempty"`
+	AllowPaidBroadcast      bool                     `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID         string                   `json:"message_effect_id,omitempty"`
+	SuggestedPostParameters *SuggestedPostParameters `json:"suggested_post_parameters,omitempty"`
+	ReplyParameters         *ReplyParameters         `json:"reply_parameters,omitempty"`
+	ReplyMarkup             ReplyMarkup              `json:"reply_markup,omitempty"`
 }
 
-func (bot *TelegramBot) SetChatMenuButton(button *ChatMenuButton) error {
-	return bot.CallMethod("setChatMenuButton", button, nil)
+// SendAnimation sends an animation file (GIF or H.264 MP4) to the specified chat.
+// Bots can currently send animation files of up to 50 MB in size.
+// Animation can be a file_id, URL, or a new file to upload (see InputFile).
+// https://core.telegram.org/bots/api#sendanimation
+func (bot *TelegramBot) SendAnimation(req *AnimationRequest) (result *Message, err error) {
+	err = bot.CallMethod("sendAnimation", req, &result)
+	return
 }
 
 // BotCommand represents a bot command.
@@ -839,27 +1458,67 @@ type BotCommand struct {
 // BotCommandScope represents the scope of bot commands.
 // @docs https://core.telegram.org/bots/api#botcommandscope
 type BotCommandScope struct {
-	Type string `json:"type"` // "default" | "all_private_chats" | "all_group_chats" | "all_chat_administrators" | "chat" | "chat_administrators" | "chat_member"
+	Type   string `json:"type"`              // "default" | "all_private_chats" | "all_group_chats" | "all_chat_administrators" | "chat" | "chat_administrators" | "chat_member"
+	ChatID any    `json:"chat_id,omitempty"` // "chat", "chat_administrators" and "chat_member" only
+	UserID int64  `json:"user_id,omitempty"` // "chat_member" only
+}
+
+// BotCommandScopeDefault covers all chats without commands of a narrower scope.
+func BotCommandScopeDefault() *BotCommandScope {
+	return &BotCommandScope{Type: "default"}
+}
+
+// BotCommandScopeAllPrivateChats covers all private chats.
+func BotCommandScopeAllPrivateChats() *BotCommandScope {
+	return &BotCommandScope{Type: "all_private_chats"}
+}
+
+// BotCommandScopeAllGroupChats covers all group and supergroup chats.
+func BotCommandScopeAllGroupChats() *BotCommandScope {
+	return &BotCommandScope{Type: "all_group_chats"}
+}
+
+// BotCommandScopeAllChatAdministrators covers all group and supergroup chat administrators.
+func BotCommandScopeAllChatAdministrators() *BotCommandScope {
+	return &BotCommandScope{Type: "all_chat_administrators"}
+}
+
+// BotCommandScopeChat covers a specific chat.
+func BotCommandScopeChat(chatID any) *BotCommandScope {
+	return &BotCommandScope{Type: "chat", ChatID: chatID}
+}
+
+// BotCommandScopeChatAdministrators covers all administrators of a specific group or supergroup chat.
+func BotCommandScopeChatAdministrators(chatID any) *BotCommandScope {
+	return &BotCommandScope{Type: "chat_administrators", ChatID: chatID}
+}
+
+// BotCommandScopeChatMember covers a specific member of a group or supergroup chat.
+func BotCommandScopeChatMember(chatID any, userID int64) *BotCommandScope {
+	return &BotCommandScope{Type: "chat_member", ChatID: chatID, UserID: userID}
 }
 
-// CommandRequest is the request for setting bot commands.
+// MyCommandsRequest is the request for setting, getting or deleting bot commands.
+// Commands are only used by SetMyCommands.
 // @docs https://core.telegram.org/bots/api#setmycommands
 type MyCommandsRequest struct {
-	Commands     []*BotCommand    `json:"commands,omitempty"`
-	Scope        *BotCommandScope `json:"scope,omitempty"`
-	LanguageCode string           `json:"language_code,omitempty"`
+	Commands []*BotCommand    `json:"commands,omitempty"`
+	Scope    *BotCommandScope `json:"scope,omitempty"` // Defaults to BotCommandScopeDefault
+	// A two-letter ISO 639-1 language code. If empty, commands are applied to all users
+	// from the given scope, for whose language there are no dedicated commands.
+	LanguageCode string `json:"language_code,omitempty"`
 }
 
 // SetMyCommands sets the list of commands for the bot.
 // Use scope to set commands for different chat types.
 // Example:
 //
-//	bot.SetMyCommands(&CommandRequest{
-//		Commands: []BotCommand{
+//	bot.SetMyCommands(&MyCommandsRequest{
+//		Commands: []*BotCommand{
 //			{Command: "start", Description: "Start the bot"},
 //			{Command: "help", Description: "Get help"},
 //		},
-//		Scope: &BotCommandScope{Type: "default"},
+//		Scope: BotCommandScopeAllPrivateChats(),
 //	})
 //
 // @docs https://core.telegram.org/bots/api#setmycommands
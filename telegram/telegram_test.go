@@ -0,0 +1,1156 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestBot returns a bot talking to a fake Bot API server.
+// handler receives the method name and the parsed request.
+func newTestBot(t *testing.T, handler func(method string, r *http.Request) any) *TelegramBot {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		out := handler(method, r)
+		if apiErr, ok := out.(*Error); ok {
+			json.NewEncoder(w).Encode(&TelegramBotResponse{Code: apiErr.Code, Description: apiErr.Description})
+			return
+		}
+		result, _ := json.Marshal(out)
+		json.NewEncoder(w).Encode(&TelegramBotResponse{Ok: true, Result: result})
+	}))
+	t.Cleanup(server.Close)
+	return NewBot(&Config{API: server.URL, Token: "test"})
+}
+
+// badRequest reports err from a handler of newTestBot, which runs in the
+// server goroutine and can't stop the test, and returns an error response.
+func badRequest(t *testing.T, err error) *Error {
+	t.Helper()
+	t.Error(err)
+	return &Error{Code: 400, Description: "Bad Request: " + err.Error()}
+}
+
+func TestSendMessageLinkPreviewDefault(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req MessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.LinkPreviewOptions == nil || !req.LinkPreviewOptions.IsDisabled {
+			t.Errorf("link_preview_options: got %+v", req.LinkPreviewOptions)
+		}
+		return &Message{MessageID: 1}
+	})
+	bot.config.LinkPreviewOptions = &LinkPreviewOptions{IsDisabled: true}
+	req := &MessageRequest{ChatID: 1, Text: "https://example.com"}
+	if _, err := bot.SendMessage(req); err != nil {
+		t.Fatal(err)
+	}
+	if req.LinkPreviewOptions != nil {
+		t.Error("the default was written to the request")
+	}
+	edit := &EditMessageTextRequest{ChatID: 1, MessageID: 1, Text: "https://example.org"}
+	if _, err := bot.EditMessageText(edit); err != nil {
+		t.Fatal(err)
+	}
+	if edit.LinkPreviewOptions != nil {
+		t.Error("the default was written to the edit request")
+	}
+}
+
+func TestSendPhotoUpload(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if method != "sendPhoto" {
+			t.Errorf("unexpected method %s", method)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return badRequest(t, err)
+		}
+		if got := r.FormValue("photo"); got != "attach://file0" {
+			t.Errorf("photo: got %q", got)
+		}
+		if got := r.FormValue("show_caption_above_media"); got != "true" {
+			t.Errorf("show_caption_above_media: got %q", got)
+		}
+		f, header, err := r.FormFile("file0")
+		if err != nil {
+			return badRequest(t, err)
+		}
+		data, _ := io.ReadAll(f)
+		if header.Filename != "cat.png" || string(data) != "png-data" {
+			t.Errorf("unexpected upload %s: %q", header.Filename, data)
+		}
+		return &Message{MessageID: 1}
+	})
+	msg, err := bot.SendPhoto(&PhotoRequest{
+		ChatID:                1,
+		Photo:                 FileBytes("cat.png", []byte("png-data")),
+		Caption:               "cat",
+		ShowCaptionAboveMedia: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.MessageID != 1 {
+		t.Errorf("unexpected result %+v", msg)
+	}
+}
+
+func TestSendPhotoByURL(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("content type: got %q", ct)
+		}
+		var req map[string]any
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["photo"] != "https://example.com/cat.png" {
+			t.Errorf("photo: got %v", req["photo"])
+		}
+		return &Message{MessageID: 2}
+	})
+	_, err := bot.SendPhoto(&PhotoRequest{ChatID: 1, Photo: FileURL("https://example.com/cat.png")})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendAudioWithThumbnail(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return badRequest(t, err)
+		}
+		if r.FormValue("audio") != "attach://file0" || r.FormValue("thumbnail") != "attach://file1" {
+			t.Errorf("unexpected file fields: %v", r.MultipartForm.Value)
+		}
+		if r.FormValue("performer") != "Band" || r.FormValue("duration") != "180" {
+			t.Errorf("unexpected metadata: %v", r.MultipartForm.Value)
+		}
+		if len(r.MultipartForm.File) != 2 {
+			t.Errorf("expected 2 uploads, got %d", len(r.MultipartForm.File))
+		}
+		return &Message{MessageID: 1}
+	})
+	_, err := bot.SendAudio(&AudioRequest{
+		ChatID:    1,
+		Audio:     FileBytes("song.mp3", []byte("mp3")),
+		Thumbnail: FileBytes("cover.jpg", []byte("jpg")),
+		Performer: "Band",
+		Title:     "Song",
+		Duration:  180,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendMediaGroup(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return badRequest(t, err)
+		}
+		var media []map[string]any
+		json.Unmarshal([]byte(r.FormValue("media")), &media)
+		if len(media) != 2 || media[0]["media"] != "attach://file0" || media[1]["media"] != "photo-id" {
+			t.Errorf("unexpected media: %v", media)
+		}
+		if media[0]["caption"] != "Album" {
+			t.Errorf("caption: got %v", media[0]["caption"])
+		}
+		return []*Message{{MessageID: 1}, {MessageID: 2}}
+	})
+	album := NewAlbum().
+		Photo(FileBytes("a.jpg", []byte("jpg")), "").
+		Photo(FileID("photo-id"), "").
+		Caption("Album", "")
+	messages, err := bot.SendMediaGroup(album.Request(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 2 {
+		t.Errorf("expected 2 messages, got %d", len(messages))
+	}
+}
+
+func TestValidateMediaGroup(t *testing.T) {
+	tests := []struct {
+		media []InputMedia
+		ok    bool
+	}{
+		{NewAlbum().Photo(FileID("a"), "").Media(), false},
+		{NewAlbum().Photo(FileID("a"), "").Video(FileID("b"), "").Media(), true},
+		{NewAlbum().Audio(FileID("a"), "").Audio(FileID("b"), "").Media(), true},
+		{NewAlbum().Photo(FileID("a"), "").Document(FileID("b"), "").Media(), false},
+		{NewAlbum().Add(NewInputMediaAnimation(FileID("a"), "")).Photo(FileID("b"), "").Media(), false},
+	}
+	for i, tt := range tests {
+		if err := validateMediaGroup(tt.media); (err == nil) != tt.ok {
+			t.Errorf("case %d: got %v", i, err)
+		}
+	}
+}
+
+func TestWithTyping(t *testing.T) {
+	interval := chatActionInterval
+	chatActionInterval = 10 * time.Millisecond
+	defer func() { chatActionInterval = interval }()
+
+	var mu sync.Mutex
+	var actions int
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req ChatAction
+		json.NewDecoder(r.Body).Decode(&req)
+		if method != "sendChatAction" || req.Action != ChatActionTyping {
+			t.Errorf("unexpected request %s %+v", method, req)
+		}
+		mu.Lock()
+		actions++
+		mu.Unlock()
+		return true
+	})
+	err := bot.WithTyping(context.Background(), 1, func() error {
+		time.Sleep(55 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if actions < 3 {
+		t.Errorf("expected the action to be repeated, got %d calls", actions)
+	}
+}
+
+func TestEditMessageTextNotModified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: message is not modified: specified new message content and reply markup are exactly the same"}`))
+	}))
+	defer server.Close()
+	bot := NewBot(&Config{API: server.URL, Token: "test"})
+	_, err := bot.EditMessageText(&EditMessageTextRequest{ChatID: 1, MessageID: 2, Text: "same"})
+	if !errors.Is(err, ErrMessageNotModified) {
+		t.Errorf("expected ErrMessageNotModified, got %v", err)
+	}
+}
+
+func TestEditInlineMessageText(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		return true
+	})
+	msg, err := bot.EditMessageText(&EditMessageTextRequest{InlineMessageID: "abc", Text: "new"})
+	if err != nil || msg != nil {
+		t.Errorf("got %v, %v", msg, err)
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bottest/getFile":
+			result, _ := json.Marshal(&File{FileID: "id", FilePath: "photos/file_1.jpg", FileSize: 5})
+			json.NewEncoder(w).Encode(&TelegramBotResponse{Ok: true, Result: result})
+		case "/file/bottest/photos/file_1.jpg":
+			w.Write([]byte("hello"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	bot := NewBot(&Config{API: server.URL, Token: "test"})
+
+	var buf strings.Builder
+	var last int64
+	file, err := bot.DownloadFile(context.Background(), "id", &buf, func(transferred, total int64) {
+		last = transferred
+		if total != 5 {
+			t.Errorf("total: got %d", total)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "hello" || last != 5 || file.FilePath != "photos/file_1.jpg" {
+		t.Errorf("got %q, progress %d, file %+v", buf.String(), last, file)
+	}
+}
+
+func TestUploadProgress(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 100<<10)
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return badRequest(t, err)
+		}
+		f, _, err := r.FormFile("file0")
+		if err != nil {
+			return badRequest(t, err)
+		}
+		got, _ := io.ReadAll(f)
+		if len(got) != len(data) {
+			t.Errorf("uploaded %d bytes, want %d", len(got), len(data))
+		}
+		return &Message{MessageID: 1}
+	})
+	var transferred, total int64
+	doc := FileBytes("blob.bin", data).WithProgress(func(n, size int64) {
+		transferred, total = n, size
+	})
+	_, err := bot.SendDocument(&DocumentRequest{ChatID: 1, Document: doc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transferred != int64(len(data)) || total != int64(len(data)) {
+		t.Errorf("progress: got %d/%d", transferred, total)
+	}
+}
+
+func TestUploadCanceled(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		t.Error("request should not complete")
+		return true
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := bot.CallMethodContext(ctx, "sendDocument", &DocumentRequest{
+		ChatID:   1,
+		Document: FileBytes("blob.bin", []byte("data")),
+	}, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestUploadCache(t *testing.T) {
+	uploads := 0
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			uploads++
+			return &Message{MessageID: 1, Photo: []*PhotoSize{{FileID: "small"}, {FileID: "large"}}}
+		}
+		var req map[string]any
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["photo"] != "large" {
+			t.Errorf("photo: got %v", req["photo"])
+		}
+		return &Message{MessageID: 2}
+	})
+	bot.config.UploadCache = NewMemoryStore()
+	for i := 0; i < 3; i++ {
+		_, err := bot.SendPhoto(&PhotoRequest{ChatID: 1, Photo: FileBytes("cat.png", []byte("png-data"))})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if uploads != 1 {
+		t.Errorf("uploaded %d times, want 1", uploads)
+	}
+	// The photo file_id can't be sent as a document
+	_, err := bot.SendDocument(&DocumentRequest{ChatID: 1, Document: FileBytes("cat.png", []byte("png-data"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uploads != 2 {
+		t.Errorf("uploaded %d times, want 2", uploads)
+	}
+}
+
+func TestUploadCacheRejected(t *testing.T) {
+	uploads := 0
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			uploads++
+			return &Message{MessageID: 1, Photo: []*PhotoSize{{FileID: fmt.Sprintf("id%d", uploads)}}}
+		}
+		var req map[string]any
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["photo"] != "id2" {
+			return &Error{Code: 400, Description: "Bad Request: wrong file identifier/HTTP URL specified"}
+		}
+		return &Message{MessageID: 2}
+	})
+	bot.config.UploadCache = NewMemoryStore()
+	// The file_id of the first upload is rejected, as after a change of bot token
+	for i := 0; i < 3; i++ {
+		_, err := bot.SendPhoto(&PhotoRequest{ChatID: 1, Photo: FileBytes("cat.png", []byte("png-data"))})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if uploads != 2 {
+		t.Errorf("uploaded %d times, want 2", uploads)
+	}
+}
+
+func TestMessageBuilder(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if method != "sendMessage" {
+			t.Errorf("unexpected method %s", method)
+		}
+		var req map[string]any
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["text"] != "hi" || req["disable_notification"] != true || req["protect_content"] != true {
+			t.Errorf("unexpected request %v", req)
+		}
+		if reply, _ := req["reply_parameters"].(map[string]any); reply["message_id"] != 42.0 {
+			t.Errorf("reply_parameters: got %v", req["reply_parameters"])
+		}
+		return &Message{MessageID: 43}
+	})
+	msg, err := bot.NewMessage(1).Text("hi").Silent().Protected().ReplyTo(42).Send(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.MessageID != 43 {
+		t.Errorf("got message %d", msg.MessageID)
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	err := error(&Error{Code: 400, Description: "Bad Request: can't set supergroup sticker set"})
+	if !errors.Is(err, ErrCantSetStickerSet) {
+		t.Error("expected ErrCantSetStickerSet")
+	}
+	if !errors.Is(&Error{Code: 400, Description: "Bad Request: STICKERSET_INVALID"}, ErrCantSetStickerSet) {
+		t.Error("expected ErrCantSetStickerSet for STICKERSET_INVALID")
+	}
+	err = &Error{Code: 400, Description: "Bad Request: not enough rights to restrict/unrestrict chat member"}
+	if !errors.Is(err, ErrNotEnoughRights) || errors.Is(err, ErrCantSetStickerSet) {
+		t.Error("expected ErrNotEnoughRights only")
+	}
+}
+
+func TestAnswerInlineQuery(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req struct {
+			Results   []map[string]any `json:"results"`
+			Button    map[string]any   `json:"button"`
+			CacheTime *int             `json:"cache_time"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Results) != 1 || req.Results[0]["type"] != "article" {
+			t.Errorf("results: got %v", req.Results)
+		}
+		if req.CacheTime == nil || *req.CacheTime != 0 {
+			t.Errorf("cache_time: got %v", req.CacheTime)
+		}
+		if req.Button["start_parameter"] != "login" {
+			t.Errorf("button: got %v", req.Button)
+		}
+		return true
+	})
+	noCache := 0
+	err := bot.AnswerInlineQuery(&AnswerInlineQueryRequest{
+		InlineQueryID: "1",
+		CacheTime:     &noCache,
+		Results: []InlineQueryResult{&InlineQueryResultArticle{
+			ID:                  "a",
+			Title:               "Hello",
+			InputMessageContent: &InputTextMessageContent{MessageText: "hello"},
+		}},
+		Button: &InlineQueryResultsButton{Text: "Sign in", StartParameter: "login"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInlinePager(t *testing.T) {
+	var answer AnswerInlineQueryRequest
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		answer = AnswerInlineQueryRequest{}
+		json.NewDecoder(r.Body).Decode(&struct {
+			NextOffset *string `json:"next_offset"`
+		}{&answer.NextOffset})
+		return true
+	})
+	pager := bot.NewInlinePager(10, func(query *InlineQuery, offset, limit int) (results []InlineQueryResult, err error) {
+		for i := offset; i < 25 && i < offset+limit; i++ {
+			results = append(results, NewInlineQueryResultArticle(fmt.Sprint(i), "item", &InputTextMessageContent{MessageText: "text"}))
+		}
+		return
+	})
+	offset := ""
+	for _, want := range []string{"10", "20", ""} {
+		if err := pager.Answer(&InlineQuery{ID: "q", Offset: offset}); err != nil {
+			t.Fatal(err)
+		}
+		if answer.NextOffset != want {
+			t.Fatalf("next_offset: got %q, want %q", answer.NextOffset, want)
+		}
+		offset = answer.NextOffset
+	}
+}
+
+func TestInlineResultIDs(t *testing.T) {
+	a, b := ArticleResult("", "Hello", "hello"), ArticleResult("", "Hello", "hello")
+	if a.ID == "" || a.ID != b.ID {
+		t.Errorf("generated ids: %q, %q", a.ID, b.ID)
+	}
+	if c := ArticleResult("", "Hello", "world"); c.ID == a.ID {
+		t.Errorf("distinct results share id %q", c.ID)
+	}
+	if p := PhotoResult("p1", "https://example.com/a.jpg", ""); p.ID != "p1" || p.ThumbnailURL != p.PhotoURL {
+		t.Errorf("photo: %+v", p)
+	}
+	if d := DocumentResult("", "https://example.com/a.zip", "A"); d.MimeType != "application/zip" {
+		t.Errorf("document mime type: %s", d.MimeType)
+	}
+}
+
+func TestStatusMessages(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		methods = append(methods, method)
+		switch {
+		case method == "editMessageText" && len(methods) == 2:
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`))
+		case method == "editMessageText":
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: message to edit not found"}`))
+		default:
+			fmt.Fprintf(w, `{"ok":true,"result":{"message_id":%d,"chat":{"id":1}}}`, len(methods))
+		}
+	}))
+	defer server.Close()
+	bot := NewBot(&Config{API: server.URL, Token: "test"})
+	status := NewStatusMessages(bot, NewMemoryStore())
+	req := &MessageRequest{ChatID: 1, StyledText: Bold("status")}
+	for i := 0; i < 3; i++ {
+		if _, err := status.Update(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if req.StyledText == nil || req.Text != "" {
+		t.Errorf("request changed to %+v", req)
+	}
+	want := "sendMessage editMessageText editMessageText sendMessage"
+	if got := strings.Join(methods, " "); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if id, _ := status.MessageID(1, 0); id != 4 {
+		t.Errorf("message id: got %d", id)
+	}
+}
+
+func TestVoteWidget(t *testing.T) {
+	var mu sync.Mutex
+	var edits []*EditMessageReplyMarkupRequest
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if method == "editMessageReplyMarkup" {
+			var req EditMessageReplyMarkupRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			mu.Lock()
+			edits = append(edits, &req)
+			mu.Unlock()
+		}
+		return true
+	})
+	votes := NewVoteWidget(bot, NewMemoryStore(), "vote", "Pizza", "Sushi")
+	var flushes []func()
+	votes.afterFunc = func(d time.Duration, f func()) { flushes = append(flushes, f) }
+	router := NewRouter()
+	votes.Register(router)
+	msg := &Message{MessageID: 5, Date: 1, Chat: &Chat{ID: 1}}
+	press := func(userID int64, option int) {
+		data, _ := router.CallbackData("vote", option)
+		router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{ID: "q", From: &User{ID: userID}, Message: msg, Data: data}}, nil)
+	}
+	press(1, 0)
+	press(2, 0)
+	press(2, 1) // changes the vote of user 2
+	press(3, 1)
+	press(3, 1) // withdraws the vote of user 3
+	if len(flushes) != 1 || len(edits) != 0 {
+		t.Fatalf("expected a single pending edit, got %d and %d edits", len(flushes), len(edits))
+	}
+	flushes[0]()
+	results, err := votes.Results(1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results.Counts[0] != 1 || results.Counts[1] != 1 || results.Voters() != 2 {
+		t.Errorf("results: %+v", results)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(edits) != 1 {
+		t.Fatalf("expected a single debounced edit, got %d", len(edits))
+	}
+	if got := edits[0].ReplyMarkup.InlineKeyboard[1][0].Text; got != "Sushi · 1" {
+		t.Errorf("button: got %q", got)
+	}
+
+	// A widget created without NewVoteWidget
+	widget := &VoteWidget{bot: bot, store: NewMemoryStore(), prefix: "vote", Options: []string{"A"}, Debounce: time.Hour}
+	query := &CallbackQuery{ID: "q", From: &User{ID: 1}, Message: msg}
+	if err := widget.Handle(query, msg, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	keyboard := make(chan *InlineKeyboardMarkup, 1)
+	var answers, edits int
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		switch method {
+		case "sendMessage":
+			var req struct {
+				ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			keyboard <- req.ReplyMarkup
+			return &Message{MessageID: 2, Chat: &Chat{ID: 1}}
+		case "answerCallbackQuery":
+			answers++
+		case "editMessageReplyMarkup":
+			edits++
+		}
+		return true
+	})
+	router := NewRouter()
+	router.Bot = bot
+	msg := &Message{MessageID: 1, Date: 1, Chat: &Chat{ID: 1}, From: &User{ID: 10}}
+	result := make(chan bool)
+	go func() {
+		ok, err := router.Confirm(context.Background(), msg, "Sure?")
+		if err != nil {
+			t.Error(err)
+		}
+		result <- ok
+	}()
+	yes := (<-keyboard).InlineKeyboard[0][0].CallbackData
+	router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{ID: "a", From: &User{ID: 11}, Data: yes}}, nil)
+	router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{ID: "b", From: &User{ID: 10}, Data: yes}}, nil)
+	if !<-result {
+		t.Error("expected confirmation")
+	}
+	if answers != 2 || edits != 1 {
+		t.Errorf("got %d answers and %d edits", answers, edits)
+	}
+	// Later presses are answered rather than passed to the handlers
+	router.OnCallbackQuery(func(query *CallbackQuery) error {
+		t.Error("expired confirmation passed to the handlers")
+		return nil
+	})
+	router.HandleUpdate(&Update{CallbackQuery: &CallbackQuery{ID: "c", From: &User{ID: 10}, Data: yes}}, nil)
+	if answers != 3 {
+		t.Errorf("expired press: got %d answers", answers)
+	}
+}
+
+func TestConfirmTimeout(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if method == "sendMessage" {
+			return &Message{MessageID: 2, Chat: &Chat{ID: 1}}
+		}
+		return true
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	router := NewRouter()
+	router.Bot = bot
+	msg := &Message{MessageID: 1, Chat: &Chat{ID: 1}, From: &User{ID: 10}}
+	ok, err := router.Confirm(ctx, msg, "Sure?")
+	if ok || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, %v", ok, err)
+	}
+	post := &Message{MessageID: 3, Chat: &Chat{ID: -100}, SenderChat: &Chat{ID: -100}}
+	if _, err := router.Confirm(ctx, post, "Sure?"); err == nil {
+		t.Error("expected an error confirming a channel post")
+	}
+}
+
+func TestMenuButton(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		return map[string]any{"type": "web_app", "text": "Open", "web_app": map[string]string{"url": "https://example.com"}}
+	})
+	button, err := bot.GetChatMenuButton(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	webApp, ok := button.(*MenuButtonWebApp)
+	if !ok || webApp.Text != "Open" || webApp.WebApp.URL != "https://example.com" {
+		t.Fatalf("got %#v", button)
+	}
+	data, _ := json.Marshal(&ChatMenuButton{ChatID: 1, MenuButton: &MenuButtonCommands{}})
+	if string(data) != `{"chat_id":1,"menu_button":{"type":"commands"}}` {
+		t.Errorf("got %s", data)
+	}
+}
+
+func TestSendInvoicePayButton(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		return &Message{MessageID: 1}
+	})
+	req := StarsInvoice(1, "Coffee", "A cup of coffee", "order-1", 50)
+	req.ReplyMarkup = NewInlineKeyboard().Row(URLButton("Menu", "https://example.com"))
+	if _, err := bot.SendInvoice(req); err == nil {
+		t.Error("expected an error without pay button")
+	}
+	req.ReplyMarkup = NewInlineKeyboard().Row(PayButton("Pay ⭐️50"))
+	if _, err := bot.SendInvoice(req); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHandlePreCheckoutQuery(t *testing.T) {
+	var answer AnswerPreCheckoutQueryRequest
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		json.NewDecoder(r.Body).Decode(&answer)
+		return true
+	})
+	router := NewRouter()
+	router.OnPreCheckoutQuery(bot.HandlePreCheckoutQuery(func(ctx context.Context, query *PreCheckoutQuery) error {
+		switch query.InvoicePayload {
+		case "sold-out":
+			return errors.New("Sold out")
+		case "broken":
+			return errors.New("")
+		}
+		return nil
+	}))
+	router.HandleUpdate(&Update{PreCheckoutQuery: &PreCheckoutQuery{ID: "1", InvoicePayload: "coffee"}}, nil)
+	if !answer.OK || answer.PreCheckoutQueryID != "1" {
+		t.Errorf("got %+v", answer)
+	}
+	router.HandleUpdate(&Update{PreCheckoutQuery: &PreCheckoutQuery{ID: "2", InvoicePayload: "sold-out"}}, nil)
+	if answer.OK || answer.ErrorMessage != "Sold out" {
+		t.Errorf("got %+v", answer)
+	}
+	router.HandleUpdate(&Update{PreCheckoutQuery: &PreCheckoutQuery{ID: "3", InvoicePayload: "broken"}}, nil)
+	if answer.OK || answer.ErrorMessage != CheckoutErrorMessage {
+		t.Errorf("got %+v", answer)
+	}
+}
+
+func TestEachStarTransaction(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req StarTransactionsRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		var page StarTransactions
+		for i := req.Offset; i < 150 && i < req.Offset+req.Limit; i++ {
+			page.Transactions = append(page.Transactions, &StarTransaction{ID: fmt.Sprint(i), Amount: 1, Source: &TransactionPartnerUser{TransactionType: "invoice_payment", User: &User{ID: 1}}})
+		}
+		return &page
+	})
+	var total int
+	err := bot.EachStarTransaction(0, func(transaction *StarTransaction) error {
+		if transaction.IsIncoming() {
+			total += transaction.Amount
+		}
+		return nil
+	})
+	if err != nil || total != 150 {
+		t.Errorf("got %d, %v", total, err)
+	}
+}
+
+func TestUnmarshalStarTransaction(t *testing.T) {
+	var transaction StarTransaction
+	data := `{"id":"1","amount":25,"date":0,"receiver":{"type":"chat","chat":{"id":-100,"type":"channel"},"gift":{"id":"g","star_count":25}}}`
+	if err := json.Unmarshal([]byte(data), &transaction); err != nil {
+		t.Fatal(err)
+	}
+	chat, ok := transaction.Receiver.(*TransactionPartnerChat)
+	if !ok || chat.Chat.ID != -100 || chat.Gift == nil || chat.Gift.ID != "g" {
+		t.Errorf("receiver: got %+v", transaction.Receiver)
+	}
+	if transaction.IsIncoming() {
+		t.Error("expected an outgoing transaction")
+	}
+}
+
+func TestSetGameScoreNotModified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: BOT_SCORE_NOT_MODIFIED"}`))
+	}))
+	defer server.Close()
+	bot := NewBot(&Config{API: server.URL, Token: "test"})
+	_, err := bot.SetGameScore(&SetGameScoreRequest{UserID: 1, Score: 10, InlineMessageID: "abc"})
+	if !errors.Is(err, ErrScoreNotModified) {
+		t.Errorf("expected ErrScoreNotModified, got %v", err)
+	}
+}
+
+func TestCreateNewStickerSetUpload(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return badRequest(t, err)
+		}
+		var stickers []map[string]any
+		json.Unmarshal([]byte(r.FormValue("stickers")), &stickers)
+		if len(stickers) != 2 || stickers[0]["sticker"] != "attach://file0" || stickers[1]["sticker"] != "sticker-id" {
+			t.Errorf("unexpected stickers: %v", stickers)
+		}
+		if _, _, err := r.FormFile("file0"); err != nil {
+			t.Error(err)
+		}
+		return true
+	})
+	err := bot.CreateNewStickerSet(&CreateNewStickerSetRequest{
+		UserID: 1,
+		Name:   "cats_by_test_bot",
+		Title:  "Cats",
+		Stickers: []*InputSticker{
+			{Sticker: FileBytes("cat.png", []byte("png")), Format: StickerFormatStatic, EmojiList: []string{"🐱"}},
+			{Sticker: FileID("sticker-id"), Format: StickerFormatStatic, EmojiList: []string{"😺"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetBusinessAccountGifts(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		return map[string]any{
+			"total_count": 2,
+			"gifts": []any{
+				map[string]any{"type": "regular", "owned_gift_id": "1", "send_date": 1, "convert_star_count": 15,
+					"gift": map[string]any{"id": "g1", "star_count": 25}},
+				map[string]any{"type": "unique", "owned_gift_id": "2", "send_date": 2, "can_be_transferred": true,
+					"gift": map[string]any{"base_name": "Cake", "name": "Cake-7", "number": 7}},
+			},
+		}
+	})
+	gifts, err := bot.GetBusinessAccountGifts(&BusinessAccountGiftsRequest{BusinessConnectionID: "bc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	regular, unique := gifts.Gifts[0], gifts.Gifts[1]
+	if regular.Gift == nil || regular.Gift.StarCount != 25 || regular.UniqueGift != nil {
+		t.Errorf("unexpected regular gift: %+v", regular)
+	}
+	if unique.UniqueGift == nil || unique.UniqueGift.Name != "Cake-7" || unique.Gift != nil {
+		t.Errorf("unexpected unique gift: %+v", unique)
+	}
+}
+
+func TestPostStoryUpload(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return badRequest(t, err)
+		}
+		var content map[string]any
+		json.Unmarshal([]byte(r.FormValue("content")), &content)
+		if content["type"] != "photo" || content["photo"] != "attach://file0" {
+			t.Errorf("unexpected content: %v", content)
+		}
+		var areas []map[string]map[string]any
+		json.Unmarshal([]byte(r.FormValue("areas")), &areas)
+		if len(areas) != 1 || areas[0]["type"]["type"] != "link" {
+			t.Errorf("unexpected areas: %v", areas)
+		}
+		return map[string]any{"chat": map[string]any{"id": 1, "type": "private"}, "id": 7}
+	})
+	story, err := bot.PostStory(&PostStoryRequest{
+		BusinessConnectionID: "bc",
+		Content:              &InputStoryContentPhoto{Photo: FileBytes("story.jpg", []byte("jpg"))},
+		ActivePeriod:         StoryActive1Day,
+		Areas: []*StoryArea{{
+			Position: &StoryAreaPosition{XPercentage: 50, YPercentage: 50, WidthPercentage: 20, HeightPercentage: 10},
+			Type:     &StoryAreaTypeLink{URL: "https://example.com"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if story.ID != 7 {
+		t.Errorf("unexpected story: %+v", story)
+	}
+	_, err = bot.PostStory(&PostStoryRequest{Content: &InputStoryContentPhoto{Photo: FileID("photo-id")}})
+	if err == nil {
+		t.Error("expected an error for a story from a file ID")
+	}
+	var video *InputStoryContentVideo
+	for _, content := range []InputStoryContent{nil, video, &InputStoryContentPhoto{}} {
+		if _, err = bot.PostStory(&PostStoryRequest{Content: content}); err == nil {
+			t.Errorf("expected an error for a story without content: %#v", content)
+		}
+	}
+}
+
+func TestChecklistInput(t *testing.T) {
+	checklist := &Checklist{
+		Title: "Groceries",
+		Tasks: []*ChecklistTask{{ID: 1, Text: "Milk", CompletionDate: 1}, {ID: 3, Text: "Eggs"}},
+	}
+	input := checklist.Input()
+	task := input.AddTask("Bread")
+	if task.ID != 4 || len(input.Tasks) != 3 || input.Tasks[0].ID != 1 || input.Tasks[1].ID != 3 {
+		t.Errorf("unexpected tasks: %+v", input.Tasks)
+	}
+	if c := NewChecklist("Todo", "a", "b"); c.Tasks[0].ID != 1 || c.Tasks[1].ID != 2 {
+		t.Errorf("unexpected tasks: %+v", c.Tasks)
+	}
+}
+
+func TestBroadcastResume(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[int64]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ChatID int64 `json:"chat_id"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		calls[req.ChatID]++
+		n := calls[req.ChatID]
+		mu.Unlock()
+		switch {
+		case req.ChatID == 2:
+			w.Write([]byte(`{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`))
+		case req.ChatID == -3:
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1003}}`))
+		case req.ChatID == 4 && n == 1:
+			w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`))
+		case req.ChatID == 6 && n == 1:
+			w.Write([]byte(`{"ok":false,"error_code":502,"description":"Bad Gateway"}`))
+		default:
+			fmt.Fprintf(w, `{"ok":true,"result":{"message_id":%d,"date":0,"chat":{"id":%d,"type":"private"}}}`, 100+req.ChatID, req.ChatID)
+		}
+	}))
+	defer server.Close()
+	bot := NewBot(&Config{API: server.URL, Token: "test"})
+	store := NewMemoryStore()
+	newBroadcast := func() *Broadcast {
+		b := NewBroadcast(bot, store, "test", func(chatID int64) *MessageRequest {
+			if chatID == 5 {
+				return nil
+			}
+			return &MessageRequest{Text: "hello"}
+		})
+		b.Rate, b.ChatInterval, b.GroupInterval = 0, 0, 0
+		return b
+	}
+	chats := []int64{1, 2, -3, 4, 5, 6}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := newBroadcast()
+	b.OnProgress = func(r *BroadcastReport) {
+		if r.Done() == 2 {
+			cancel()
+		}
+	}
+	if _, err := b.Run(ctx, chats); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if report, _ := b.Report(); report == nil || report.Done() != 2 {
+		t.Fatalf("checkpoint not saved: %+v", report)
+	}
+
+	report, err := newBroadcast().Run(context.Background(), chats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Sent != 3 || report.Failed != 2 || report.Retry != 1 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if r := report.Results[6]; r.Status != BroadcastRetry {
+		t.Errorf("unexpected result for a server error: %+v", r)
+	}
+	if r := report.Results[2]; r.Status != BroadcastBlocked {
+		t.Errorf("unexpected result for a blocked chat: %+v", r)
+	}
+	if r := report.Results[-3]; r.Status != BroadcastSent || r.MigratedTo != -1003 {
+		t.Errorf("unexpected result for a migrated chat: %+v", r)
+	}
+	if r := report.Results[5]; r.Status != BroadcastSkipped {
+		t.Errorf("unexpected result for a skipped chat: %+v", r)
+	}
+	if report, err = newBroadcast().Run(context.Background(), chats); err != nil {
+		t.Fatal(err)
+	}
+	if report.Sent != 4 || report.Failed != 2 || report.Retry != 0 {
+		t.Errorf("unexpected report after retrying: %+v", report)
+	}
+	if calls[1] != 1 || calls[2] != 1 || calls[4] != 2 || calls[6] != 2 {
+		t.Errorf("unexpected calls: %v", calls)
+	}
+}
+
+func TestSchedulerTTL(t *testing.T) {
+	calls := make(chan string, 4)
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req map[string]any
+		json.NewDecoder(r.Body).Decode(&req)
+		calls <- fmt.Sprintf("%s %v", method, req["chat_id"])
+		if method == "sendMessage" {
+			return map[string]any{"message_id": 7, "date": 0, "chat": map[string]any{"id": 42, "type": "private"}}
+		}
+		return true
+	})
+	store := NewMemoryStore()
+	scheduler, err := NewScheduler(bot, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = scheduler.ScheduleMessage(time.Now(), &MessageRequest{ChatID: 42, Text: "hi"}, 50*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	// Jobs survive a restart
+	if scheduler, err = NewScheduler(bot, store); err != nil || len(scheduler.Jobs()) != 1 {
+		t.Fatalf("jobs not loaded: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go scheduler.Run(ctx)
+	for _, want := range []string{"sendMessage 42", "deleteMessage 42"} {
+		select {
+		case got := <-calls:
+			if got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+	// The job is removed once the deletion returned
+	deadline := time.Now().Add(2 * time.Second)
+	for len(scheduler.Jobs()) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected jobs left: %+v", scheduler.Jobs())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerRetry(t *testing.T) {
+	status := 502
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"ok":false,"error_code":%d,"description":"error"}`, status)
+	}))
+	defer server.Close()
+	scheduler, err := NewScheduler(NewBot(&Config{API: server.URL, Token: "test"}), NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &MessageRequest{ChatID: 42, StyledText: &StyledText{Text: "hi"}}
+	id, err := scheduler.ScheduleMessage(time.Now(), req, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.StyledText == nil || req.Text != "" {
+		t.Error("the request was modified")
+	}
+	if err = scheduler.run(scheduler.Jobs()[0]); err == nil {
+		t.Fatal("expected an error")
+	}
+	jobs := scheduler.Jobs()
+	if len(jobs) != 1 || jobs[0].ID != id || jobs[0].Attempts != 1 || time.Until(jobs[0].At) < 20*time.Second {
+		t.Fatalf("job not rescheduled: %+v", jobs)
+	}
+	status = 403
+	scheduler.run(jobs[0])
+	if jobs := scheduler.Jobs(); len(jobs) != 0 {
+		t.Errorf("job not dropped: %+v", jobs)
+	}
+}
+
+func TestQuizLeaderboard(t *testing.T) {
+	bot := newTestBot(t, func(method string, r *http.Request) any {
+		var req map[string]any
+		json.NewDecoder(r.Body).Decode(&req)
+		if req["correct_option_id"] != 0.0 || req["is_anonymous"] != false || req["type"] != "quiz" {
+			t.Errorf("unexpected poll: %v", req)
+		}
+		return map[string]any{
+			"message_id": 1, "date": 0,
+			"chat": map[string]any{"id": 42, "type": "group"},
+			"poll": map[string]any{"id": "poll-1", "question": req["question"]},
+		}
+	})
+	quiz := NewQuiz(bot, NewMemoryStore(), "capitals",
+		&QuizQuestion{Question: "Capital of Japan?", Options: []string{"Tokyo", "Osaka"}, Correct: 0, Points: 2},
+	)
+	router := NewRouter()
+	quiz.Register(router)
+	otherPolls := 0
+	router.OnPollAnswer(func(answer *PollAnswer) error {
+		otherPolls++
+		return nil
+	})
+	if _, err := quiz.Send(42, 0); err != nil {
+		t.Fatal(err)
+	}
+	answer := func(pollID string, userID int64, option int) {
+		router.HandleUpdate(&Update{PollAnswer: &PollAnswer{
+			PollID:    pollID,
+			User:      &User{ID: userID, FirstName: fmt.Sprint("user", userID)},
+			OptionIDs: []int{option},
+		}}, nil)
+	}
+	answer("poll-1", 1, 1)
+	answer("poll-1", 2, 0)
+	answer("other", 3, 0)
+	leaders, err := quiz.Leaderboard(42, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leaders) != 2 || leaders[0].UserID != 2 || leaders[0].Points != 2 || leaders[1].Answered != 1 || leaders[1].Points != 0 {
+		t.Errorf("unexpected leaderboard: %+v", leaders)
+	}
+	if otherPolls != 1 {
+		t.Errorf("answers to other polls should be left to other handlers, got %d", otherPolls)
+	}
+	router.HandleUpdate(&Update{Poll: &Poll{ID: "poll-1", IsClosed: true}}, nil)
+	if poll, _ := quiz.poll("poll-1"); poll != nil {
+		t.Error("closed poll not forgotten")
+	}
+	quiz.OpenPeriod = time.Hour
+	if _, err := quiz.Send(42, 0); err == nil {
+		t.Error("expected an error for an open period over 600 seconds")
+	}
+}
+
+func TestLiveLocationUpdaterRetry(t *testing.T) {
+	calls := make(chan string, 8)
+	edits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		calls <- method
+		if method == "editMessageLiveLocation" {
+			if edits++; edits == 1 {
+				w.Write([]byte(`{"ok":false,"error_code":502,"description":"Bad Gateway"}`))
+				return
+			}
+		}
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer server.Close()
+	bot := NewBot(&Config{API: server.URL, Token: "test"})
+	updater := bot.NewLiveLocationUpdater(&Message{MessageID: 1, Chat: &Chat{ID: 1}}, 10*time.Millisecond)
+	locations := make(chan *Location, 1)
+	locations <- &Location{Latitude: 1, Longitude: 2}
+	done := make(chan error)
+	go func() { done <- updater.Run(context.Background(), locations) }()
+	for _, want := range []string{"editMessageLiveLocation", "editMessageLiveLocation"} {
+		if got := <-calls; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	close(locations)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got := <-calls; got != "stopMessageLiveLocation" {
+		t.Errorf("got %s, want stopMessageLiveLocation", got)
+	}
+
+	// A zero interval defaults to DefaultLiveLocationInterval
+	updater.Interval = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := updater.Run(ctx, make(chan *Location)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,131 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// Templates is a set of named text/template message templates for a parse
+// mode. Values interpolated by the templates are escaped for the parse
+// mode, use the "raw" function to insert markup as is:
+//
+//	t := telegram.NewTemplates(telegram.ParseModeHTML)
+//	t.Add("order_confirmed", "<b>Order #{{.ID}}</b> confirmed, {{.Name}}!")
+type Templates struct {
+	mode    ParseMode
+	tmpl    *template.Template
+	escaped map[*parse.Tree]bool // Trees whose actions are piped to escapeFunc
+}
+
+// rawText is a template value inserted without escaping.
+type rawText string
+
+// escapeFunc is the name of the function appended to every template action.
+const escapeFunc = "telegram_escape"
+
+// NewTemplates returns an empty set of templates rendering markup for mode.
+func NewTemplates(mode ParseMode) *Templates {
+	t := &Templates{mode: mode, escaped: make(map[*parse.Tree]bool)}
+	t.tmpl = template.New("").Funcs(template.FuncMap{
+		"raw": func(v any) rawText {
+			return rawText(fmt.Sprint(v))
+		},
+		escapeFunc: func(v any) string {
+			if raw, ok := v.(rawText); ok {
+				return string(raw)
+			}
+			return Escape(mode, fmt.Sprint(v))
+		},
+	})
+	return t
+}
+
+// ParseMode returns the parse mode of the rendered texts.
+func (t *Templates) ParseMode() ParseMode {
+	return t.mode
+}
+
+// Funcs adds functions to the templates, it must be called before Add.
+func (t *Templates) Funcs(funcs template.FuncMap) *Templates {
+	t.tmpl.Funcs(funcs)
+	return t
+}
+
+// Add parses text as the template name. Templates defined in text with
+// {{define}} are added as well, replacing the templates of the same name.
+func (t *Templates) Add(name, text string) error {
+	if _, err := t.tmpl.New(name).Parse(text); err != nil {
+		return err
+	}
+	// Trees are tracked rather than names, as redefined templates get new trees
+	escaped := make(map[*parse.Tree]bool)
+	for _, tmpl := range t.tmpl.Templates() {
+		if tmpl.Tree == nil {
+			continue
+		}
+		if !t.escaped[tmpl.Tree] {
+			escapeActions(tmpl.Tree.Root)
+		}
+		escaped[tmpl.Tree] = true
+	}
+	t.escaped = escaped
+	return nil
+}
+
+// Render executes the template name with data.
+func (t *Templates) Render(name string, data any) (string, error) {
+	var b strings.Builder
+	if err := t.tmpl.ExecuteTemplate(&b, name, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// escapeActions pipes the output of every action in node to escapeFunc.
+func escapeActions(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			escapeActions(child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) == 0 {
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Args:     []parse.Node{parse.NewIdentifier(escapeFunc).SetPos(n.Pos)},
+			})
+		}
+	case *parse.IfNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.RangeNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.WithNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	}
+}
+
+// SendTemplate renders the template name of Config.Templates with data and
+// sends the result to chatID.
+func (bot *TelegramBot) SendTemplate(chatID any, name string, data any) (result *Message, err error) {
+	templates := bot.config.Templates
+	if templates == nil {
+		return nil, fmt.Errorf("telegram: no templates configured")
+	}
+	text, err := templates.Render(name, data)
+	if err != nil {
+		return
+	}
+	return bot.SendMessage(&MessageRequest{
+		ChatID:    chatID,
+		Text:      text,
+		ParseMode: templates.ParseMode(),
+	})
+}
@@ -0,0 +1,48 @@
+package telegram
+
+import "testing"
+
+func TestTemplatesEscape(t *testing.T) {
+	templates := NewTemplates(ParseModeHTML)
+	err := templates.Add("order", `<b>Order #{{.ID}}</b> for {{.Name}}{{range .Items}}
+- {{.}}{{end}}{{if .Note}}
+{{raw .Note}}{{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := templates.Render("order", map[string]any{
+		"ID":    7,
+		"Name":  "<script>",
+		"Items": []string{"fish & chips"},
+		"Note":  "<i>thanks</i>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "<b>Order #7</b> for &lt;script&gt;\n- fish &amp; chips\n<i>thanks</i>"
+	if text != want {
+		t.Errorf("got %q, want %q", text, want)
+	}
+}
+
+func TestTemplatesRedefine(t *testing.T) {
+	templates := NewTemplates(ParseModeHTML)
+	if err := templates.Add("x", "<b>{{.}}</b>"); err != nil {
+		t.Fatal(err)
+	}
+	if err := templates.Add("x", "<u>{{.}}</u>"); err != nil {
+		t.Fatal(err)
+	}
+	if err := templates.Add("y", `{{define "x"}}<s>{{.}}</s>{{end}}{{template "x" .}}`); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"x": "<s>&lt;i&gt;</s>", "y": "<s>&lt;i&gt;</s>"} {
+		text, err := templates.Render(name, "<i>")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text != want {
+			t.Errorf("%s: got %q, want %q", name, text, want)
+		}
+	}
+}
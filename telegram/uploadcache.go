@@ -0,0 +1,192 @@
+package telegram
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+// uploadCachePrefix namespaces upload cache entries in Config.UploadCache.
+const uploadCachePrefix = "upload:"
+
+// uploadOnlyMethods take uploads that can't be replaced by the file_id of a
+// message, such as profile photos, sticker files and stories.
+var uploadOnlyMethods = map[string]bool{
+	"setChatPhoto":                   true,
+	"setBusinessAccountProfilePhoto": true,
+	"uploadStickerFile":              true,
+	"createNewStickerSet":            true,
+	"addStickerToSet":                true,
+	"replaceStickerInSet":            true,
+	"setStickerSetThumbnail":         true,
+	"postStory":                      true,
+	"editStory":                      true,
+}
+
+// contentKey returns the cache key of an upload sent by method in field,
+// derived from the SHA-256 of its content, as the same content sent as a
+// photo or a document gets a different file_id. Only files that can be read
+// twice (local paths and io.Seeker readers) are cached, for others ok is false.
+func (f *InputFile) contentKey(method string) (key string, ok bool, err error) {
+	h := sha256.New()
+	switch r := f.Reader.(type) {
+	case nil:
+		file, err := os.Open(f.Path)
+		if err != nil {
+			return "", false, err
+		}
+		defer file.Close()
+		if _, err = io.Copy(h, file); err != nil {
+			return "", false, err
+		}
+	case io.ReadSeeker:
+		start, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return "", false, nil
+		}
+		if _, err = io.Copy(h, r); err != nil {
+			return "", false, err
+		}
+		if _, err = r.Seek(start, io.SeekStart); err != nil {
+			return "", false, err
+		}
+	default:
+		return "", false, nil
+	}
+	key = fmt.Sprintf("%s%s:%s:%s", uploadCachePrefix, method, f.field, hex.EncodeToString(h.Sum(nil)))
+	return key, true, nil
+}
+
+// useUploadCache replaces uploads of method already known to the cache by their file_id.
+// It returns the remaining uploads and, when a single file is left to
+// upload, the cache key to record its file_id under once sent.
+// Thumbnails are always uploaded, their file_id isn't returned.
+func (bot *TelegramBot) useUploadCache(method string, files []*InputFile) (uploads []*InputFile, key string, err error) {
+	if uploadOnlyMethods[method] {
+		return files, "", nil
+	}
+	store := bot.config.UploadCache
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		var k string
+		ok := false
+		if f.field != "thumbnail" {
+			if k, ok, err = f.contentKey(method); err != nil {
+				return nil, "", err
+			}
+		}
+		if ok {
+			fileID, found, err := store.Get(k)
+			if err != nil {
+				return nil, "", err
+			}
+			if found {
+				f.cached, f.cacheKey = string(fileID), k
+				continue
+			}
+		}
+		uploads = append(uploads, f)
+		keys = append(keys, k)
+	}
+	// The file_id of a sent message can only be attributed to its main media
+	if len(files) == 1 && len(uploads) == 1 {
+		key = keys[0]
+	}
+	return
+}
+
+// uploadOffsets returns the offsets of the readers of files, so that they can
+// be uploaded again, or false if one of them can't be read twice.
+func uploadOffsets(files []*InputFile) (offsets []int64, ok bool) {
+	for _, f := range files {
+		var offset int64
+		if f.Reader != nil {
+			// Closers such as an *os.File are closed once uploaded
+			seeker, isSeeker := f.Reader.(io.Seeker)
+			if _, isCloser := f.Reader.(io.Closer); !isSeeker || isCloser {
+				return nil, false
+			}
+			var err error
+			if offset, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+				return nil, false
+			}
+		}
+		offsets = append(offsets, offset)
+	}
+	return offsets, true
+}
+
+// isBadRequest reports whether the API rejected a request, possibly because of a cached file_id.
+func isBadRequest(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code == 400
+}
+
+// forgetUploads deletes the cache entries of the files sent by file_id in a
+// rejected request, so that they are uploaded instead, and rewinds the files
+// uploaded to offsets. It returns the cache key to record the new file_id under,
+// as useUploadCache.
+func (bot *TelegramBot) forgetUploads(files, uploaded []*InputFile, offsets []int64) (key string, err error) {
+	for i, f := range uploaded {
+		if seeker, ok := f.Reader.(io.Seeker); ok {
+			if _, err = seeker.Seek(offsets[i], io.SeekStart); err != nil {
+				return
+			}
+		}
+	}
+	for _, f := range files {
+		if f.cached == "" {
+			continue
+		}
+		if err = bot.config.UploadCache.Delete(f.cacheKey); err != nil {
+			return
+		}
+		f.cached = ""
+	}
+	if len(files) == 1 {
+		key = files[0].cacheKey
+	}
+	return
+}
+
+// rememberUpload records the file_id of the media in result, if any.
+// The message is already sent, so a failure of the cache is only logged.
+func (bot *TelegramBot) rememberUpload(key string, result json.RawMessage) {
+	var msg Message
+	if json.Unmarshal(result, &msg) != nil {
+		return
+	}
+	if fileID := msg.mediaFileID(); fileID != "" {
+		if err := bot.config.UploadCache.Set(key, []byte(fileID)); err != nil {
+			log.Println("telegram: upload cache:", err)
+		}
+	}
+}
+
+// mediaFileID returns the file_id of the media attached to the message.
+func (m *Message) mediaFileID() string {
+	switch {
+	case len(m.Photo) > 0:
+		return m.Photo[len(m.Photo)-1].FileID
+	case m.Animation != nil:
+		return m.Animation.FileID
+	case m.Audio != nil:
+		return m.Audio.FileID
+	case m.Document != nil:
+		return m.Document.FileID
+	case m.Sticker != nil:
+		return m.Sticker.FileID
+	case m.Video != nil:
+		return m.Video.FileID
+	case m.VideoNote != nil:
+		return m.VideoNote.FileID
+	case m.Voice != nil:
+		return m.Voice.FileID
+	}
+	return ""
+}
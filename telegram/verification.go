@@ -0,0 +1,44 @@
+package telegram
+
+type VerifyUserRequest struct {
+	UserID int64 `json:"user_id"`
+	// Custom description for the verification, 0-70 characters;
+	// must be empty if the organization isn't allowed to provide one
+	CustomDescription string `json:"custom_description,omitempty"`
+}
+
+// VerifyUser verifies a user on behalf of the organization which is represented by the bot.
+// https://core.telegram.org/bots/api#verifyuser
+func (bot *TelegramBot) VerifyUser(userID int64, customDescription string) error {
+	return bot.CallMethod("verifyUser", &VerifyUserRequest{UserID: userID, CustomDescription: customDescription}, nil)
+}
+
+type VerifyChatRequest struct {
+	// Channel direct messages chats can't be verified
+	ChatID            any    `json:"chat_id"`
+	CustomDescription string `json:"custom_description,omitempty"`
+}
+
+// VerifyChat verifies a chat on behalf of the organization which is represented by the bot.
+// https://core.telegram.org/bots/api#verifychat
+func (bot *TelegramBot) VerifyChat(chatID any, customDescription string) error {
+	return bot.CallMethod("verifyChat", &VerifyChatRequest{ChatID: chatID, CustomDescription: customDescription}, nil)
+}
+
+type UserRequest struct {
+	UserID int64 `json:"user_id"`
+}
+
+// RemoveUserVerification removes the verification from a user who is currently
+// verified on behalf of the organization represented by the bot.
+// https://core.telegram.org/bots/api#removeuserverification
+func (bot *TelegramBot) RemoveUserVerification(userID int64) error {
+	return bot.CallMethod("removeUserVerification", &UserRequest{UserID: userID}, nil)
+}
+
+// RemoveChatVerification removes the verification from a chat that is currently
+// verified on behalf of the organization represented by the bot.
+// https://core.telegram.org/bots/api#removechatverification
+func (bot *TelegramBot) RemoveChatVerification(chatID any) error {
+	return bot.CallMethod("removeChatVerification", &ChatRequest{ChatID: chatID}, nil)
+}
@@ -0,0 +1,240 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// VoteWidget is an inline keyboard of options showing live vote counts,
+// with votes stored per message and user in a Store:
+//
+//	votes := telegram.NewVoteWidget(bot, store, "vote", "Pizza", "Sushi", "Tacos")
+//	votes.Register(router)
+//	votes.Send(&telegram.MessageRequest{ChatID: chatID, Text: "Dinner?"})
+//
+// Pressing an option votes for it, pressing it again withdraws the vote.
+type VoteWidget struct {
+	bot     *TelegramBot
+	store   Store
+	prefix  string
+	Options []string
+	// Multiple allows users to vote for several options, by default
+	// a vote replaces the previous one.
+	Multiple bool
+	// Debounce delays the update of the keyboard after a vote, so that a
+	// burst of votes results in a single edit. Zero updates it at once.
+	Debounce time.Duration
+	// Format returns the text of the button of an option, by default "Pizza · 3".
+	Format func(option string, count int) string
+
+	mu      sync.Mutex
+	pending map[string]bool
+	// afterFunc schedules the debounced edits, time.AfterFunc if nil
+	afterFunc func(d time.Duration, f func())
+}
+
+// VoteResults is a snapshot of the votes of a message.
+type VoteResults struct {
+	Options []string
+	Counts  []int
+	// Votes maps users to the indexes of the options they voted for.
+	Votes map[int64][]int
+}
+
+// Voters returns the number of users who voted.
+func (r *VoteResults) Voters() int {
+	return len(r.Votes)
+}
+
+// NewVoteWidget returns a widget for options, routing its callback queries
+// with prefix and keeping votes in store. Edits are debounced by a second.
+func NewVoteWidget(bot *TelegramBot, store Store, prefix string, options ...string) *VoteWidget {
+	return &VoteWidget{
+		bot:      bot,
+		store:    store,
+		prefix:   prefix,
+		Options:  options,
+		Debounce: time.Second,
+	}
+}
+
+// Register handles the votes of the widget's messages with router.
+func (w *VoteWidget) Register(router *Router) {
+	OnCallback(router, w.prefix, w.Handle)
+}
+
+// Keyboard returns the keyboard of the widget showing results, or no votes if nil.
+func (w *VoteWidget) Keyboard(results *VoteResults) *InlineKeyboardMarkup {
+	kb := NewInlineKeyboard()
+	for i, option := range w.Options {
+		count := 0
+		if results != nil && i < len(results.Counts) {
+			count = results.Counts[i]
+		}
+		data, _ := EncodeCallback(nil, w.prefix, i)
+		kb.Row(CallbackButton(w.format(option, count), data))
+	}
+	return kb
+}
+
+func (w *VoteWidget) format(option string, count int) string {
+	if w.Format != nil {
+		return w.Format(option, count)
+	}
+	if count == 0 {
+		return option
+	}
+	return fmt.Sprintf("%s · %d", option, count)
+}
+
+// Send sends a message with the keyboard of the widget.
+func (w *VoteWidget) Send(req *MessageRequest) (*Message, error) {
+	m := *req
+	m.ReplyMarkup = w.Keyboard(nil)
+	return w.bot.SendMessage(&m)
+}
+
+func voteKey(query *CallbackQuery) string {
+	if query.InlineMessageID != "" {
+		return "votes:inline:" + query.InlineMessageID
+	}
+	return fmt.Sprintf("votes:%d:%d", query.Message.Chat.ID, query.Message.MessageID)
+}
+
+// Results returns the votes of a message sent with Send.
+func (w *VoteWidget) Results(chatID, messageID int64) (*VoteResults, error) {
+	return w.results(fmt.Sprintf("votes:%d:%d", chatID, messageID))
+}
+
+// InlineResults returns the votes of a message sent in inline mode.
+func (w *VoteWidget) InlineResults(inlineMessageID string) (*VoteResults, error) {
+	return w.results("votes:inline:" + inlineMessageID)
+}
+
+func (w *VoteWidget) results(key string) (results *VoteResults, err error) {
+	results = &VoteResults{
+		Options: w.Options,
+		Counts:  make([]int, len(w.Options)),
+		Votes:   make(map[int64][]int),
+	}
+	value, ok, err := w.store.Get(key)
+	if err != nil || !ok {
+		return
+	}
+	if err = json.Unmarshal(value, &results.Votes); err != nil {
+		return
+	}
+	for _, options := range results.Votes {
+		for _, i := range options {
+			if i >= 0 && i < len(results.Counts) {
+				results.Counts[i]++
+			}
+		}
+	}
+	return
+}
+
+// Handle toggles the vote of the user for option and updates the keyboard.
+func (w *VoteWidget) Handle(query *CallbackQuery, message *Message, option int) error {
+	if option < 0 || option >= len(w.Options) {
+		return w.bot.AnswerCallback(query, "")
+	}
+	if query.InlineMessageID == "" && message == nil {
+		return w.bot.AnswerCallback(query, "This vote is closed")
+	}
+	key := voteKey(query)
+	results, voted, err := w.toggle(key, query.From.ID, option)
+	if err != nil {
+		return err
+	}
+	text := "Vote withdrawn"
+	if voted {
+		text = "You voted for " + w.Options[option]
+	}
+	if err = w.bot.AnswerCallback(query, text); err != nil {
+		return err
+	}
+	return w.update(key, query, results)
+}
+
+// toggle adds or withdraws a vote and returns the new results.
+func (w *VoteWidget) toggle(key string, userID int64, option int) (results *VoteResults, voted bool, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if results, err = w.results(key); err != nil {
+		return
+	}
+	var options []int
+	for _, i := range results.Votes[userID] {
+		if i == option {
+			voted = true
+		} else if w.Multiple {
+			options = append(options, i)
+		}
+	}
+	voted = !voted
+	if voted {
+		options = append(options, option)
+	}
+	if len(options) > 0 {
+		results.Votes[userID] = options
+	} else {
+		delete(results.Votes, userID)
+	}
+	value, err := json.Marshal(results.Votes)
+	if err != nil {
+		return
+	}
+	err = w.store.Set(key, value)
+	return
+}
+
+// update edits the keyboard, at once or after Debounce with the results at that time.
+func (w *VoteWidget) update(key string, query *CallbackQuery, results *VoteResults) error {
+	req := &EditMessageReplyMarkupRequest{InlineMessageID: query.InlineMessageID}
+	if query.InlineMessageID == "" {
+		req.ChatID, req.MessageID = query.Message.Chat.ID, query.Message.MessageID
+	}
+	if w.Debounce <= 0 {
+		return w.edit(req, results)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.pending[key] {
+		return nil
+	}
+	if w.pending == nil {
+		w.pending = make(map[string]bool)
+	}
+	w.pending[key] = true
+	afterFunc := w.afterFunc
+	if afterFunc == nil {
+		afterFunc = func(d time.Duration, f func()) { time.AfterFunc(d, f) }
+	}
+	afterFunc(w.Debounce, func() {
+		w.mu.Lock()
+		delete(w.pending, key)
+		results, err := w.results(key)
+		w.mu.Unlock()
+		if err == nil {
+			err = w.edit(req, results)
+		}
+		if err != nil {
+			log.Println("telegram: vote widget:", err)
+		}
+	})
+	return nil
+}
+
+func (w *VoteWidget) edit(req *EditMessageReplyMarkupRequest, results *VoteResults) error {
+	req.ReplyMarkup = w.Keyboard(results)
+	_, err := w.bot.EditMessageReplyMarkup(req)
+	if errors.Is(err, ErrMessageNotModified) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+package telegram
+
+// WebAppInfo describes a Web App.
+// @docs https://core.telegram.org/bots/api#webappinfo
+type WebAppInfo struct {
+	// An HTTPS URL of a Web App to be opened with additional data as specified in Initializing Web Apps
+	URL string `json:"url"`
+}
+
+// WebAppData describes data sent from a Web App to the bot.
+// @docs https://core.telegram.org/bots/api#webappdata
+type WebAppData struct {
+	// The data. Be aware that a bad client can send arbitrary data in this field.
+	Data string `json:"data"`
+	// Text of the web_app keyboard button from which the Web App was opened.
+	// Be aware that a bad client can send arbitrary data in this field.
+	ButtonText string `json:"button_text"`
+}
+
+// SentWebAppMessage describes an inline message sent by a Web App on behalf of a user.
+// @docs https://core.telegram.org/bots/api#sentwebappmessage
+type SentWebAppMessage struct {
+	// Identifier of the sent inline message, only set if there is an inline keyboard attached to the message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+type AnswerWebAppQueryRequest struct {
+	WebAppQueryID string            `json:"web_app_query_id"`
+	Result        InlineQueryResult `json:"result"`
+}
+
+// AnswerWebAppQuery sets the result of an interaction with a Web App and sends
+// a corresponding message on behalf of the user to the chat from which the query originated.
+// https://core.telegram.org/bots/api#answerwebappquery
+func (bot *TelegramBot) AnswerWebAppQuery(webAppQueryID string, result InlineQueryResult) (message *SentWebAppMessage, err error) {
+	err = bot.CallMethod("answerWebAppQuery", &AnswerWebAppQueryRequest{WebAppQueryID: webAppQueryID, Result: result}, &message)
+	return
+}
@@ -0,0 +1,66 @@
+package webapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// LoginUser is a user authenticated with the Telegram Login Widget.
+// @docs https://core.telegram.org/widgets/login#receiving-authorization-data
+type LoginUser struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Username  string
+	PhotoURL  string
+	AuthDate  time.Time
+}
+
+// VerifyLoginWidget checks the authorization data sent by the Telegram Login
+// Widget to a website linked to the bot with the given token, and returns the
+// user. fields are the query parameters of the redirect, or the fields of the
+// object passed to the onauth callback. Data older than maxAge is rejected,
+// unless maxAge is 0.
+// @docs https://core.telegram.org/widgets/login#checking-authorization
+func VerifyLoginWidget(fields map[string]string, botToken string, maxAge time.Duration) (*LoginUser, error) {
+	hash := fields["hash"]
+	if hash == "" {
+		return nil, ErrMissingHash
+	}
+	values := url.Values{}
+	for key, value := range fields {
+		values.Set(key, value)
+	}
+	secret := sha256.Sum256([]byte(botToken))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte(dataCheckString(values, "hash")))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(hash)) {
+		return nil, ErrInvalidHash
+	}
+	id, err := strconv.ParseInt(fields["id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("webapp: invalid id: %w", err)
+	}
+	authDate, err := strconv.ParseInt(fields["auth_date"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("webapp: invalid auth_date: %w", err)
+	}
+	user := &LoginUser{
+		ID:        id,
+		FirstName: fields["first_name"],
+		LastName:  fields["last_name"],
+		Username:  fields["username"],
+		PhotoURL:  fields["photo_url"],
+		AuthDate:  time.Unix(authDate, 0),
+	}
+	if maxAge > 0 && time.Since(user.AuthDate) > maxAge {
+		return nil, ErrExpired
+	}
+	return user, nil
+}
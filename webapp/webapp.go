@@ -0,0 +1,208 @@
+// Package webapp validates the data passed by Telegram to Mini Apps.
+//
+// A Mini App receives Telegram.WebApp.initData, which it forwards to its
+// backend. The backend must validate it before trusting its content:
+//
+//	data, err := webapp.ValidateInitData(initData, botToken, time.Hour)
+//	if err != nil {
+//		// reject the request
+//	}
+//	log.Println(data.User.ID)
+//
+// @docs https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app
+package webapp
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrMissingHash is returned when the init data is not signed.
+	ErrMissingHash = errors.New("webapp: init data is not signed")
+	// ErrInvalidHash is returned when the signature of the init data doesn't match.
+	ErrInvalidHash = errors.New("webapp: invalid init data signature")
+	// ErrExpired is returned when the init data is older than the accepted age.
+	ErrExpired = errors.New("webapp: init data expired")
+)
+
+// Public keys used by Telegram to sign init data for third parties, see ValidateInitDataSignature.
+var (
+	ProductionPublicKey = mustDecodeKey("e7bf03a2fa4602af4580703d88dda5bb59f32ed8b02a56c187fe7d34caed242d")
+	TestPublicKey       = mustDecodeKey("40055058a4ee38156a06562e52eece92a771bcd8346a8c4615cb7376eddf72ec")
+)
+
+func mustDecodeKey(s string) ed25519.PublicKey {
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return ed25519.PublicKey(key)
+}
+
+// WebAppUser contains the data of a Mini App user.
+// @docs https://core.telegram.org/bots/webapps#webappuser
+type WebAppUser struct {
+	ID                    int64  `json:"id"`
+	IsBot                 bool   `json:"is_bot,omitempty"`
+	FirstName             string `json:"first_name"`
+	LastName              string `json:"last_name,omitempty"`
+	Username              string `json:"username,omitempty"`
+	LanguageCode          string `json:"language_code,omitempty"`
+	IsPremium             bool   `json:"is_premium,omitempty"`
+	AddedToAttachmentMenu bool   `json:"added_to_attachment_menu,omitempty"`
+	AllowsWriteToPm       bool   `json:"allows_write_to_pm,omitempty"`
+	PhotoURL              string `json:"photo_url,omitempty"`
+}
+
+// WebAppChat represents a chat, for Mini Apps launched from the attachment menu.
+// @docs https://core.telegram.org/bots/webapps#webappchat
+type WebAppChat struct {
+	ID       int64  `json:"id"`
+	Type     string `json:"type"` // "group" | "supergroup" | "channel"
+	Title    string `json:"title"`
+	Username string `json:"username,omitempty"`
+	PhotoURL string `json:"photo_url,omitempty"`
+}
+
+// InitData contains the data transferred to a Mini App when it is opened.
+// @docs https://core.telegram.org/bots/webapps#webappinitdata
+type InitData struct {
+	// Unique identifier for the Mini App session, required to send messages via AnswerWebAppQuery
+	QueryID  string      `json:"query_id,omitempty"`
+	User     *WebAppUser `json:"user,omitempty"`
+	Receiver *WebAppUser `json:"receiver,omitempty"` // Chat partner, for the attachment menu in private chats
+	Chat     *WebAppChat `json:"chat,omitempty"`
+	// "sender" | "private" | "group" | "supergroup" | "channel"
+	ChatType     string `json:"chat_type,omitempty"`
+	ChatInstance string `json:"chat_instance,omitempty"`
+	StartParam   string `json:"start_param,omitempty"`
+	CanSendAfter int    `json:"can_send_after,omitempty"` // Seconds after which a message can be sent via AnswerWebAppQuery
+	AuthDate     time.Time
+	Hash         string `json:"hash"`
+	Signature    string `json:"signature,omitempty"`
+}
+
+// Parse parses init data without validating it.
+func Parse(initData string) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, err
+	}
+	return parseValues(values)
+}
+
+func parseValues(values url.Values) (data *InitData, err error) {
+	data = &InitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		Hash:         values.Get("hash"),
+		Signature:    values.Get("signature"),
+	}
+	for key, out := range map[string]any{"user": &data.User, "receiver": &data.Receiver, "chat": &data.Chat} {
+		if v := values.Get(key); v != "" {
+			if err = json.Unmarshal([]byte(v), out); err != nil {
+				return nil, fmt.Errorf("webapp: invalid %s: %w", key, err)
+			}
+		}
+	}
+	if v := values.Get("can_send_after"); v != "" {
+		if data.CanSendAfter, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("webapp: invalid can_send_after: %w", err)
+		}
+	}
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("webapp: invalid auth_date: %w", err)
+	}
+	data.AuthDate = time.Unix(authDate, 0)
+	return
+}
+
+// dataCheckString returns the sorted key=value pairs of values, except the excluded keys.
+func dataCheckString(values url.Values, exclude ...string) string {
+	var pairs []string
+	for key := range values {
+		excluded := false
+		for _, e := range exclude {
+			excluded = excluded || key == e
+		}
+		if !excluded {
+			pairs = append(pairs, key+"="+values.Get(key))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\n")
+}
+
+// checkAge returns ErrExpired if data is older than maxAge. A zero maxAge accepts any age.
+func checkAge(data *InitData, maxAge time.Duration) error {
+	if maxAge > 0 && time.Since(data.AuthDate) > maxAge {
+		return ErrExpired
+	}
+	return nil
+}
+
+// ValidateInitData validates init data received by a Mini App of the bot with
+// the given token, and returns its content. Data older than maxAge is
+// rejected, unless maxAge is 0.
+func ValidateInitData(initData, botToken string, maxAge time.Duration) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, err
+	}
+	hash := values.Get("hash")
+	if hash == "" {
+		return nil, ErrMissingHash
+	}
+	secret := hmac.New(sha256.New, []byte("WebAppData"))
+	secret.Write([]byte(botToken))
+	mac := hmac.New(sha256.New, secret.Sum(nil))
+	mac.Write([]byte(dataCheckString(values, "hash")))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(hash)) {
+		return nil, ErrInvalidHash
+	}
+	data, err := parseValues(values)
+	if err != nil {
+		return nil, err
+	}
+	return data, checkAge(data, maxAge)
+}
+
+// ValidateInitDataSignature validates init data signed by Telegram for a third party,
+// which only knows the bot ID and not its token. publicKey is ProductionPublicKey,
+// or TestPublicKey for the test environment. Data older than maxAge is
+// rejected, unless maxAge is 0.
+func ValidateInitDataSignature(initData string, botID int64, publicKey ed25519.PublicKey, maxAge time.Duration) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(values.Get("signature"))
+	if err != nil || len(signature) == 0 {
+		return nil, ErrMissingHash
+	}
+	message := fmt.Sprintf("%d:WebAppData\n%s", botID, dataCheckString(values, "hash", "signature"))
+	if !ed25519.Verify(publicKey, []byte(message), signature) {
+		return nil, ErrInvalidHash
+	}
+	data, err := parseValues(values)
+	if err != nil {
+		return nil, err
+	}
+	return data, checkAge(data, maxAge)
+}
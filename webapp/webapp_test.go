@@ -0,0 +1,94 @@
+package webapp
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func testValues(authDate time.Time) url.Values {
+	return url.Values{
+		"query_id":  {"AAHdF6IQAAAAAN0XohDhrOrc"},
+		"user":      {`{"id":279058397,"first_name":"Vladislav","username":"vdkfrost","language_code":"ru"}`},
+		"auth_date": {strconv.FormatInt(authDate.Unix(), 10)},
+	}
+}
+
+func sign(values url.Values, botToken string) string {
+	secret := hmac.New(sha256.New, []byte("WebAppData"))
+	secret.Write([]byte(botToken))
+	mac := hmac.New(sha256.New, secret.Sum(nil))
+	mac.Write([]byte(dataCheckString(values)))
+	values.Set("hash", hex.EncodeToString(mac.Sum(nil)))
+	return values.Encode()
+}
+
+func TestValidateInitData(t *testing.T) {
+	initData := sign(testValues(time.Now()), "123:token")
+	data, err := ValidateInitData(initData, "123:token", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.User == nil || data.User.ID != 279058397 || data.User.Username != "vdkfrost" {
+		t.Errorf("user: got %+v", data.User)
+	}
+	if _, err := ValidateInitData(initData, "123:other", time.Hour); err != ErrInvalidHash {
+		t.Errorf("wrong token: got %v", err)
+	}
+	old := sign(testValues(time.Now().Add(-2*time.Hour)), "123:token")
+	if _, err := ValidateInitData(old, "123:token", time.Hour); err != ErrExpired {
+		t.Errorf("old data: got %v", err)
+	}
+}
+
+func TestValidateInitDataSignature(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := testValues(time.Now())
+	values.Set("hash", "ignored")
+	message := fmt.Sprintf("%d:WebAppData\n%s", 123, dataCheckString(values, "hash"))
+	values.Set("signature", base64.RawURLEncoding.EncodeToString(ed25519.Sign(private, []byte(message))))
+	if _, err := ValidateInitDataSignature(values.Encode(), 123, public, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ValidateInitDataSignature(values.Encode(), 124, public, time.Hour); err != ErrInvalidHash {
+		t.Errorf("wrong bot: got %v", err)
+	}
+}
+
+func TestVerifyLoginWidget(t *testing.T) {
+	fields := map[string]string{
+		"id":         "42",
+		"first_name": "Ann",
+		"username":   "ann",
+		"auth_date":  strconv.FormatInt(time.Now().Unix(), 10),
+	}
+	values := url.Values{}
+	for k, v := range fields {
+		values.Set(k, v)
+	}
+	secret := sha256.Sum256([]byte("123:token"))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte(dataCheckString(values)))
+	fields["hash"] = hex.EncodeToString(mac.Sum(nil))
+	user, err := VerifyLoginWidget(fields, "123:token", 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 42 || user.Username != "ann" {
+		t.Errorf("got %+v", user)
+	}
+	fields["id"] = "43"
+	if _, err := VerifyLoginWidget(fields, "123:token", 0); err != ErrInvalidHash {
+		t.Errorf("tampered data: got %v", err)
+	}
+}